@@ -78,6 +78,15 @@ func initConfigOptions() {
 	_ = viper.BindEnv("api.proxy", "HTTPS_PROXY") // For compatibility with DefaultTransport
 	_ = viper.BindPFlag("api.proxy", pflag.Lookup("api-proxy"))
 
+	pflag.Int("metamodel-ttl", 3600, "Time in seconds after which the cached Collibra metamodel is refreshed, 0 to never refresh (env: COLLIBRA_MCP_METAMODEL_TTL)")
+	_ = viper.BindEnv("metamodel.ttl", "COLLIBRA_MCP_METAMODEL_TTL")
+	_ = viper.BindPFlag("metamodel.ttl", pflag.Lookup("metamodel-ttl"))
+	viper.SetDefault("metamodel.ttl", 3600)
+
+	pflag.String("metamodel-cache-path", "", "Optional path to persist the cached Collibra metamodel (env: COLLIBRA_MCP_METAMODEL_CACHE_PATH)")
+	_ = viper.BindEnv("metamodel.cache-path", "COLLIBRA_MCP_METAMODEL_CACHE_PATH")
+	_ = viper.BindPFlag("metamodel.cache-path", pflag.Lookup("metamodel-cache-path"))
+
 	pflag.String("mode", "stdio", "MCP server mode: 'stdio', 'http', 'http-sse', or 'http-streamable' (env: COLLIBRA_MCP_MODE)")
 	_ = viper.BindEnv("mcp.mode", "COLLIBRA_MCP_MODE")
 	_ = viper.BindPFlag("mcp.mode", pflag.Lookup("mode"))
@@ -95,6 +104,21 @@ func initConfigOptions() {
 	pflag.StringSlice("disabled-tools", []string{}, "Optional comma-separated list of tool names to disable while enabling the remaining tools (cannot be used with enabled-tools) (env: COLLIBRA_MCP_DISABLED_TOOLS)")
 	_ = viper.BindEnv("mcp.disabled-tools", "COLLIBRA_MCP_DISABLED_TOOLS")
 	_ = viper.BindPFlag("mcp.disabled-tools", pflag.Lookup("disabled-tools"))
+
+	pflag.String("comment-marker", "[Posted by an AI agent]", "Marker added to the comments posted by the agent, empty to disable (env: COLLIBRA_MCP_COMMENT_MARKER)")
+	_ = viper.BindEnv("mcp.comment-marker", "COLLIBRA_MCP_COMMENT_MARKER")
+	_ = viper.BindPFlag("mcp.comment-marker", pflag.Lookup("comment-marker"))
+	viper.SetDefault("mcp.comment-marker", "[Posted by an AI agent]")
+
+	pflag.Int("copilot-history-turns", 10, "Number of earlier questions and answers per session sent to the Copilot agents, 0 to disable (env: COLLIBRA_MCP_COPILOT_HISTORY_TURNS)")
+	_ = viper.BindEnv("mcp.copilot-history-turns", "COLLIBRA_MCP_COPILOT_HISTORY_TURNS")
+	_ = viper.BindPFlag("mcp.copilot-history-turns", pflag.Lookup("copilot-history-turns"))
+	viper.SetDefault("mcp.copilot-history-turns", 10)
+
+	pflag.String("copilot", "auto", "Whether the AI Copilot is available: 'auto' to detect it at startup, 'enabled' or 'disabled'. Without it, the discover tools search instead (env: COLLIBRA_MCP_COPILOT)")
+	_ = viper.BindEnv("mcp.copilot", "COLLIBRA_MCP_COPILOT")
+	_ = viper.BindPFlag("mcp.copilot", pflag.Lookup("copilot"))
+	viper.SetDefault("mcp.copilot", "auto")
 }
 
 func printUsage(version string) {
@@ -114,8 +138,13 @@ ENVIRONMENT VARIABLES:
   COLLIBRA_MCP_SSO_AUTH         Enable browser-based SSO authentication (default: false)
   COLLIBRA_MCP_SSO_CACHE_PATH   Path to cache SSO session
   COLLIBRA_MCP_SSO_TIMEOUT      Timeout in seconds for SSO authentication (default: 300)
+  COLLIBRA_MCP_METAMODEL_TTL    Seconds after which the cached metamodel is refreshed (default: 3600)
+  COLLIBRA_MCP_METAMODEL_CACHE_PATH  Path to persist the cached metamodel
   COLLIBRA_MCP_MODE             Server mode: 'stdio' or 'http' (default: stdio)
   COLLIBRA_MCP_HTTP_PORT        HTTP server port (default: 8080)
+  COLLIBRA_MCP_COMMENT_MARKER   Marker added to comments posted by the agent (default: [Posted by an AI agent])
+  COLLIBRA_MCP_COPILOT_HISTORY_TURNS  Earlier questions per session sent to the Copilot agents (default: 10)
+  COLLIBRA_MCP_COPILOT          Whether the AI Copilot is available: auto, enabled or disabled (default: auto)
 
 CONFIGURATION:
   Configuration can be provided in the following order of precedence: command-line flags (highest), environment variables, or a YAML configuration file (lowest).
@@ -128,6 +157,8 @@ CONFIGURATION FILE EXAMPLE:
   api:
     url: "https://pggm.collibra.com"
     sso-auth: true
+  metamodel:
+    ttl: 3600
   mcp:
     mode: "stdio"
     http:
@@ -141,6 +172,21 @@ func validateConfigFile(config Config) {
 		os.Exit(1)
 	}
 
+	if config.Metamodel.TTL < 0 {
+		slog.Error(fmt.Sprintf("Invalid metamodel TTL: %d (must be 0 or greater)", config.Metamodel.TTL))
+		os.Exit(1)
+	}
+
+	if config.Mcp.CopilotHistoryTurns < 0 {
+		slog.Error(fmt.Sprintf("Invalid Copilot history turns: %d (must be 0 or greater)", config.Mcp.CopilotHistoryTurns))
+		os.Exit(1)
+	}
+
+	if config.Mcp.Copilot != "auto" && config.Mcp.Copilot != "enabled" && config.Mcp.Copilot != "disabled" {
+		slog.Error(fmt.Sprintf("Invalid Copilot setting: %s (must be 'auto', 'enabled' or 'disabled')", config.Mcp.Copilot))
+		os.Exit(1)
+	}
+
 	if len(config.Mcp.EnabledTools) > 0 && len(config.Mcp.DisabledTools) > 0 {
 		slog.Error("Cannot specify both enabled-tools and disabled-tools, only one can be specified")
 		os.Exit(1)
@@ -168,8 +214,9 @@ func readConfigFile() Config {
 }
 
 type Config struct {
-	Api CollibraApiConfig `mapstructure:"api"`
-	Mcp McpConfig         `mapstructure:"mcp"`
+	Api       CollibraApiConfig `mapstructure:"api"`
+	Metamodel MetamodelConfig   `mapstructure:"metamodel"`
+	Mcp       McpConfig         `mapstructure:"mcp"`
 }
 
 // CollibraApiConfig holds Collibra-specific configuration
@@ -182,6 +229,12 @@ type CollibraApiConfig struct {
 	SkipTLSVerify bool   `mapstructure:"skip-tls-verify"`
 }
 
+// MetamodelConfig holds the configuration of the metamodel cache
+type MetamodelConfig struct {
+	TTL       int    `mapstructure:"ttl"`
+	CachePath string `mapstructure:"cache-path"`
+}
+
 // ServerConfig holds server configuration
 type McpConfig struct {
 	Mode          string      `mapstructure:"mode"` // "stdio", "http", "http-sse", or "http-streamable"
@@ -189,6 +242,10 @@ type McpConfig struct {
 	Stdio         StdioConfig `mapstructure:"stdio"`
 	EnabledTools  []string    `mapstructure:"enabled-tools"`
 	DisabledTools []string    `mapstructure:"disabled-tools"`
+	CommentMarker string      `mapstructure:"comment-marker"`
+	// CopilotHistoryTurns is the number of earlier questions and answers per session sent to the Copilot agents
+	CopilotHistoryTurns int    `mapstructure:"copilot-history-turns"`
+	Copilot             string `mapstructure:"copilot"` // "auto", "enabled" or "disabled"
 }
 
 type HttpConfig struct {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/tls"
 	"fmt"
@@ -15,6 +16,18 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// startupProbeKey marks the context of the requests made at startup, outside any tool call.
+type startupProbeKey struct{}
+
+func withStartupProbe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, startupProbeKey{}, true)
+}
+
+func isStartupProbe(ctx context.Context) bool {
+	probe, _ := ctx.Value(startupProbeKey{}).(bool)
+	return probe
+}
+
 type collibraClient struct {
 	config *Config
 	next   http.RoundTripper
@@ -56,16 +69,22 @@ func (c *collibraClient) RoundTrip(request *http.Request) (*http.Response, error
 	}
 	reqClone := request.Clone(request.Context())
 	toolRequest, ok := chip.GetCallToolRequest(reqClone.Context())
-	if !ok {
+	if !ok && !isStartupProbe(reqClone.Context()) {
 		return nil, fmt.Errorf("toolRequest not found in ctx")
 	}
+	// The startup probes run outside any tool call, so they can only authenticate with the cookie.
+	if !ok && c.config.Api.Cookie == "" {
+		return nil, fmt.Errorf("a session cookie is required outside tool calls")
+	}
 	if c.config.Api.Cookie != "" {
 		reqClone.Header.Set("Cookie", c.config.Api.Cookie)
 	} else {
 		copyHeader(toolRequest, reqClone, "Authorization")
 	}
 	reqClone.Header.Set("X-MCP-Session-Id", chip.GetSessionId(reqClone.Context()))
-	reqClone.Header.Set("X-MCP-Tool-Name", toolRequest.Params.Name)
+	if ok {
+		reqClone.Header.Set("X-MCP-Tool-Name", toolRequest.Params.Name)
+	}
 	reqClone.Header.Set("traceparent", generateTraceParent())
 	reqClone.URL.Scheme = baseURL.Scheme
 	reqClone.URL.Host = baseURL.Host
@@ -11,6 +11,8 @@ import (
 
 	"github.com/collibra/chip/pkg/auth"
 	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 	"github.com/collibra/chip/pkg/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -41,10 +43,18 @@ func main() {
 	client := newCollibraClient(config)
 	server := chip.NewServer(chip.WithToolMiddleware(chip.ToolMiddlewareFunc(setCollibraHost(config.Api.Url))))
 	toolConfig := &chip.ToolConfig{
-		EnabledTools:  config.Mcp.EnabledTools,
-		DisabledTools: config.Mcp.DisabledTools,
+		EnabledTools:        config.Mcp.EnabledTools,
+		DisabledTools:       config.Mcp.DisabledTools,
+		CommentMarker:       config.Mcp.CommentMarker,
+		CopilotHistoryTurns: config.Mcp.CopilotHistoryTurns,
+		CopilotDisabled:     !isCopilotAvailable(config, client),
 	}
-	tools.RegisterAll(server, client, toolConfig)
+	metamodelCache := metamodel.NewCache(client, metamodel.Options{
+		TTL:  time.Duration(config.Metamodel.TTL) * time.Second,
+		Path: config.Metamodel.CachePath,
+		URL:  config.Api.Url,
+	})
+	tools.RegisterAll(server, client, toolConfig, metamodelCache)
 
 	if config.Mcp.Mode == "stdio" {
 		runStdioServer(server)
@@ -56,6 +66,34 @@ func main() {
 	}
 }
 
+// isCopilotAvailable decides whether to register the AI Copilot tools or their search based fallback. In auto mode the
+// Collibra instance is probed, which needs a session cookie; without one, the Copilot is assumed to be available.
+func isCopilotAvailable(config *Config, client *http.Client) bool {
+	switch config.Mcp.Copilot {
+	case "enabled":
+		return true
+	case "disabled":
+		slog.Info("AI Copilot disabled, using the search based discover tools")
+		return false
+	}
+
+	if config.Api.Cookie == "" {
+		slog.Info("No session cookie to detect the AI Copilot with, assuming it is available")
+		return true
+	}
+	ctx, cancel := context.WithTimeout(withStartupProbe(context.Background()), 30*time.Second)
+	defer cancel()
+	available, err := clients.ProbeCopilot(ctx, client)
+	if err != nil {
+		slog.Warn(fmt.Sprintf("Failed to detect the AI Copilot, assuming it is available: %v", err))
+		return true
+	}
+	if !available {
+		slog.Info("AI Copilot not available, using the search based discover tools")
+	}
+	return available
+}
+
 // handleSSOAuthentication performs browser-based SSO authentication
 func handleSSOAuthentication(config *Config) error {
 	cache := auth.NewSessionCache(config.Api.SSOCachePath)
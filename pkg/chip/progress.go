@@ -0,0 +1,36 @@
+package chip
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// NotifyProgress tells the client calling the tool in ctx how far along the tool is. When the client asked for
+// progress notifications, a progress notification is sent; otherwise the message is sent as a log message, which the
+// client only receives when it set a log level. Failures to notify are logged and otherwise ignored.
+func NotifyProgress(ctx context.Context, progress float64, message string) {
+	toolRequest, ok := GetCallToolRequest(ctx)
+	if !ok || toolRequest.Session == nil || toolRequest.Params == nil {
+		return
+	}
+
+	var err error
+	if token := toolRequest.Params.GetProgressToken(); token != nil {
+		err = toolRequest.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: token,
+			Progress:      progress,
+			Message:       message,
+		})
+	} else {
+		err = toolRequest.Session.Log(ctx, &mcp.LoggingMessageParams{
+			Level:  "info",
+			Logger: toolRequest.Params.Name,
+			Data:   message,
+		})
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to notify the client of progress", "error", err)
+	}
+}
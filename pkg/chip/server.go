@@ -50,6 +50,12 @@ func NewServer(opts ...ServerOption) *Server {
 type ToolConfig struct {
 	EnabledTools  []string
 	DisabledTools []string
+	// CommentMarker is added to the comments posted by tools, so people can tell them apart from their own.
+	CommentMarker string
+	// CopilotHistoryTurns is the number of earlier questions and answers per session sent along to the Copilot agents.
+	CopilotHistoryTurns int
+	// CopilotDisabled registers a search based fallback for the Copilot tools, for instances without the AI Copilot.
+	CopilotDisabled bool
 }
 
 func (tc *ToolConfig) IsToolEnabled(toolName string) bool {
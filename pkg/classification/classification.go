@@ -0,0 +1,283 @@
+// Package classification evaluates the rules of data classes against sample columns, without calling Collibra.
+package classification
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/collibra/chip/pkg/clients"
+)
+
+// DefaultConfidenceThreshold is the percentage of values a rule must match when a data class has no threshold.
+const DefaultConfidenceThreshold = 80
+
+const maxExamples = 3
+
+// Class is the part of a data class that decides whether a column belongs to it.
+type Class struct {
+	ID                  string
+	Name                string
+	ColumnNameFilters   []string
+	ColumnTypeFilters   []string
+	AllowNullValues     bool
+	AllowEmptyValues    bool
+	ConfidenceThreshold int
+	Rules               []clients.DataClassRule
+}
+
+// Column is a sample of a column: its name, its data type and some of its values. Blank values stand for null and
+// empty values.
+type Column struct {
+	Name     string
+	DataType string
+	Values   []string
+}
+
+// RuleResult tells how a single rule fared against a column.
+type RuleResult struct {
+	Rule       clients.DataClassRule `json:"rule"`
+	Fired      bool                  `json:"fired"`
+	Matched    int                   `json:"matched"`
+	Evaluated  int                   `json:"evaluated"`
+	Confidence int                   `json:"confidence"`
+	Examples   []string              `json:"examples,omitempty"`
+}
+
+// Result tells whether a column belongs to a data class. A column excluded by the column filters never matches,
+// but its rules are still evaluated so that they can be tuned.
+type Result struct {
+	Matched    bool         `json:"matched"`
+	Confidence int          `json:"confidence"`
+	Threshold  int          `json:"threshold"`
+	Excluded   string       `json:"excluded,omitempty"`
+	Rules      []RuleResult `json:"rules"`
+}
+
+// FiredRules returns the results of the rules that fired.
+func (r Result) FiredRules() []RuleResult {
+	var fired []RuleResult
+	for _, rule := range r.Rules {
+		if rule.Fired {
+			fired = append(fired, rule)
+		}
+	}
+	return fired
+}
+
+// Classifier is a Class whose patterns and dictionaries are compiled.
+type Classifier struct {
+	class             Class
+	threshold         int
+	columnNameFilters []*regexp.Regexp
+	rules             []compiledRule
+}
+
+type compiledRule struct {
+	rule       clients.DataClassRule
+	pattern    *regexp.Regexp
+	dictionary map[string]bool
+}
+
+// FromDataClass converts a data class returned by Collibra to a Class.
+func FromDataClass(dataClass clients.DataClass) (Class, error) {
+	class := Class{
+		ID:                  dataClass.ID,
+		Name:                dataClass.Name,
+		ColumnNameFilters:   dataClass.ColumnNameFilters,
+		ColumnTypeFilters:   dataClass.ColumnTypeFilters,
+		AllowNullValues:     dataClass.AllowNullValues,
+		AllowEmptyValues:    dataClass.AllowEmptyValues,
+		ConfidenceThreshold: dataClass.ConfidenceThreshold,
+	}
+	for i, raw := range dataClass.Rules {
+		var rule clients.DataClassRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return Class{}, fmt.Errorf("failed to parse rule %d of data class '%s': %w", i+1, dataClass.Name, err)
+		}
+		class.Rules = append(class.Rules, rule)
+	}
+	return class, nil
+}
+
+// Compile checks the filters and rules of a class and prepares them for evaluation.
+func Compile(class Class) (*Classifier, error) {
+	classifier := &Classifier{class: class, threshold: class.ConfidenceThreshold}
+	if classifier.threshold <= 0 {
+		classifier.threshold = DefaultConfidenceThreshold
+	}
+	if classifier.threshold > 100 {
+		return nil, fmt.Errorf("confidence threshold %d is above 100", class.ConfidenceThreshold)
+	}
+
+	for _, filter := range class.ColumnNameFilters {
+		pattern, err := compilePattern(filter, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid column name filter '%s': %w", filter, err)
+		}
+		classifier.columnNameFilters = append(classifier.columnNameFilters, pattern)
+	}
+
+	for i, rule := range class.Rules {
+		compiled, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %d (%s): %w", i+1, ruleLabel(rule), err)
+		}
+		classifier.rules = append(classifier.rules, compiled)
+	}
+	return classifier, nil
+}
+
+// CheckRule checks that a rule can be evaluated: that its type is supported and its pattern or values are valid.
+func CheckRule(rule clients.DataClassRule) error {
+	_, err := compileRule(rule)
+	return err
+}
+
+func compileRule(rule clients.DataClassRule) (compiledRule, error) {
+	compiled := compiledRule{rule: rule}
+	switch strings.ToUpper(rule.Type) {
+	case clients.DataClassRuleTypeRegex, clients.DataClassRuleTypeColumnName:
+		if rule.Pattern == "" {
+			return compiledRule{}, fmt.Errorf("a %s rule needs a pattern", rule.Type)
+		}
+		pattern, err := compilePattern(rule.Pattern, rule.CaseSensitive)
+		if err != nil {
+			return compiledRule{}, err
+		}
+		compiled.pattern = pattern
+	case clients.DataClassRuleTypeDictionary:
+		compiled.dictionary = make(map[string]bool, len(rule.Values))
+		for _, value := range rule.Values {
+			if value = strings.TrimSpace(value); value != "" {
+				compiled.dictionary[normalize(value, rule.CaseSensitive)] = true
+			}
+		}
+		if len(compiled.dictionary) == 0 {
+			return compiledRule{}, fmt.Errorf("a %s rule needs values", rule.Type)
+		}
+	default:
+		return compiledRule{}, fmt.Errorf("unsupported rule type '%s', expected one of %s, %s or %s",
+			rule.Type, clients.DataClassRuleTypeRegex, clients.DataClassRuleTypeColumnName, clients.DataClassRuleTypeDictionary)
+	}
+	return compiled, nil
+}
+
+// compilePattern compiles a pattern that must match a whole value.
+func compilePattern(pattern string, caseSensitive bool) (*regexp.Regexp, error) {
+	flags := "(?i)"
+	if caseSensitive {
+		flags = ""
+	}
+	return regexp.Compile(flags + "^(?:" + pattern + ")$")
+}
+
+func normalize(value string, caseSensitive bool) string {
+	if caseSensitive {
+		return value
+	}
+	return strings.ToLower(value)
+}
+
+func ruleLabel(rule clients.DataClassRule) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	return rule.Type
+}
+
+// Class returns the class the classifier was compiled from.
+func (c *Classifier) Class() Class {
+	return c.class
+}
+
+// Evaluate evaluates the rules of the class against a column. A value rule fires when the share of values it
+// matches reaches the confidence threshold, a column name rule fires when it matches the column name. The column
+// matches the class when it passes the column filters and at least one rule fires.
+func (c *Classifier) Evaluate(column Column) Result {
+	result := Result{Threshold: c.threshold, Excluded: c.excluded(column), Rules: make([]RuleResult, 0, len(c.rules))}
+	for _, rule := range c.rules {
+		ruleResult := c.evaluateRule(rule, column)
+		if ruleResult.Confidence > result.Confidence {
+			result.Confidence = ruleResult.Confidence
+		}
+		if ruleResult.Fired && result.Excluded == "" {
+			result.Matched = true
+		}
+		result.Rules = append(result.Rules, ruleResult)
+	}
+	return result
+}
+
+func (c *Classifier) excluded(column Column) string {
+	if len(c.columnNameFilters) > 0 && !anyMatch(c.columnNameFilters, column.Name) {
+		return fmt.Sprintf("column name '%s' does not match any column name filter", column.Name)
+	}
+	if len(c.class.ColumnTypeFilters) > 0 && column.DataType != "" && !containsFold(c.class.ColumnTypeFilters, column.DataType) {
+		return fmt.Sprintf("data type '%s' does not match any column type filter", column.DataType)
+	}
+	return ""
+}
+
+func (c *Classifier) evaluateRule(rule compiledRule, column Column) RuleResult {
+	result := RuleResult{Rule: rule.rule}
+	if strings.EqualFold(rule.rule.Type, clients.DataClassRuleTypeColumnName) {
+		result.Evaluated = 1
+		if rule.pattern.MatchString(column.Name) {
+			result.Matched = 1
+			result.Confidence = 100
+			result.Fired = true
+			result.Examples = []string{column.Name}
+		}
+		return result
+	}
+
+	for _, value := range column.Values {
+		value = strings.TrimSpace(value)
+		if value == "" && (c.class.AllowNullValues || c.class.AllowEmptyValues) {
+			continue
+		}
+		result.Evaluated++
+		if !rule.matches(value) {
+			continue
+		}
+		result.Matched++
+		if len(result.Examples) < maxExamples {
+			result.Examples = append(result.Examples, value)
+		}
+	}
+	if result.Evaluated > 0 {
+		result.Confidence = result.Matched * 100 / result.Evaluated
+		result.Fired = result.Matched > 0 && result.Confidence >= c.threshold
+	}
+	return result
+}
+
+func (r compiledRule) matches(value string) bool {
+	if value == "" {
+		return false
+	}
+	if r.pattern != nil {
+		return r.pattern.MatchString(value)
+	}
+	return r.dictionary[normalize(value, r.rule.CaseSensitive)]
+}
+
+func anyMatch(patterns []*regexp.Regexp, value string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(strings.TrimSpace(candidate), strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
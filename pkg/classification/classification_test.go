@@ -0,0 +1,139 @@
+package classification_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/collibra/chip/pkg/classification"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+func emailClass() classification.Class {
+	return classification.Class{
+		Name:              "Email Address",
+		ColumnTypeFilters: []string{"VARCHAR", "TEXT"},
+		AllowEmptyValues:  true,
+		Rules: []clients.DataClassRule{
+			{Name: "email", Type: clients.DataClassRuleTypeRegex, Pattern: `[\w.+-]+@[\w-]+\.[\w.]+`},
+			{Name: "column", Type: clients.DataClassRuleTypeColumnName, Pattern: `.*e_?mail.*`},
+		},
+	}
+}
+
+func TestEvaluate_ValueAndColumnNameRules(t *testing.T) {
+	classifier, err := classification.Compile(emailClass())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	result := classifier.Evaluate(classification.Column{
+		Name:     "contact",
+		DataType: "varchar",
+		Values:   []string{"ann@example.com", "bob@example.org", "", "carl@example.net", "dave@example.com", "not an email"},
+	})
+	if !result.Matched || result.Threshold != classification.DefaultConfidenceThreshold {
+		t.Fatalf("Expected the column to match at the default threshold, got: %+v", result)
+	}
+	values, column := result.Rules[0], result.Rules[1]
+	if !values.Fired || values.Matched != 4 || values.Evaluated != 5 || values.Confidence != 80 {
+		t.Errorf("Expected 4 of 5 non-blank values to match, got: %+v", values)
+	}
+	if column.Fired {
+		t.Errorf("Expected the column name rule not to fire for 'contact'")
+	}
+}
+
+func TestEvaluate_Threshold(t *testing.T) {
+	class := emailClass()
+	class.ConfidenceThreshold = 90
+	classifier, err := classification.Compile(class)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	result := classifier.Evaluate(classification.Column{
+		Name:   "contact",
+		Values: []string{"ann@example.com", "bob@example.org", "carl@example.net", "n/a"},
+	})
+	if result.Matched || result.Confidence != 75 || result.Rules[0].Fired {
+		t.Fatalf("Expected 75%% not to reach a 90%% threshold, got: %+v", result)
+	}
+	if !slices.Equal(result.Rules[0].Examples, []string{"ann@example.com", "bob@example.org", "carl@example.net"}) {
+		t.Errorf("Unexpected examples: %v", result.Rules[0].Examples)
+	}
+
+	result = classifier.Evaluate(classification.Column{Name: "Customer_EMail"})
+	if !result.Matched || len(result.FiredRules()) != 1 || result.FiredRules()[0].Rule.Name != "column" {
+		t.Errorf("Expected only the column name rule to fire, got: %+v", result)
+	}
+}
+
+func TestEvaluate_Filters(t *testing.T) {
+	class := emailClass()
+	class.ColumnNameFilters = []string{"mail.*"}
+	classifier, err := classification.Compile(class)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for name, column := range map[string]classification.Column{
+		"name": {Name: "contact", DataType: "VARCHAR", Values: []string{"ann@example.com"}},
+		"type": {Name: "mail", DataType: "INTEGER", Values: []string{"ann@example.com"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result := classifier.Evaluate(column)
+			if result.Matched || result.Excluded == "" || !result.Rules[0].Fired {
+				t.Errorf("Expected the column to be excluded while its rules are evaluated, got: %+v", result)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Dictionary(t *testing.T) {
+	classifier, err := classification.Compile(classification.Class{
+		Name:                "Country",
+		ConfidenceThreshold: 50,
+		Rules:               []clients.DataClassRule{{Type: clients.DataClassRuleTypeDictionary, Values: []string{"Belgium", "France", " Spain "}}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	result := classifier.Evaluate(classification.Column{Name: "c", Values: []string{"belgium", "SPAIN", "Mars", ""}})
+	if !result.Matched || result.Rules[0].Matched != 2 || result.Rules[0].Evaluated != 4 {
+		t.Errorf("Expected 2 of 4 values to match, blank ones included, got: %+v", result.Rules[0])
+	}
+}
+
+func TestCompile_InvalidRules(t *testing.T) {
+	for name, rule := range map[string]clients.DataClassRule{
+		"pattern":    {Type: clients.DataClassRuleTypeRegex, Pattern: "[a-"},
+		"no pattern": {Type: clients.DataClassRuleTypeColumnName},
+		"no values":  {Type: clients.DataClassRuleTypeDictionary, Values: []string{" "}},
+		"type":       {Type: "FUZZY", Pattern: "x"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := classification.Compile(classification.Class{Rules: []clients.DataClassRule{rule}}); err == nil {
+				t.Errorf("Expected %+v to be rejected", rule)
+			}
+		})
+	}
+}
+
+func TestFromDataClass(t *testing.T) {
+	class, err := classification.FromDataClass(clients.DataClass{
+		Name:  "Email Address",
+		Rules: []json.RawMessage{json.RawMessage(`{"id":"r1","type":"REGEX","pattern":".+@.+"}`)},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(class.Rules) != 1 || class.Rules[0].ID != "r1" || class.Rules[0].Pattern != ".+@.+" {
+		t.Errorf("Unexpected rules: %+v", class.Rules)
+	}
+
+	if _, err := classification.FromDataClass(clients.DataClass{Rules: []json.RawMessage{json.RawMessage(`"regex"`)}}); err == nil {
+		t.Errorf("Expected a malformed rule to be rejected")
+	}
+}
@@ -1,6 +1,7 @@
 package clients
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -8,67 +9,192 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"regexp"
+	"strings"
 )
 
-func AskGlossary(ctx context.Context, collibraHttpClient *http.Client, question string) (string, error) {
-	slog.InfoContext(ctx, fmt.Sprintf("Data glossary agent query: '%s'", question))
-	return callTool(ctx, collibraHttpClient, "/rest/aiCopilot/v1/tools/askGlossary", question)
+const (
+	UserMessagerRole      = "user"
+	AssistantMessagerRole = "assistant"
+)
+
+// TextStreamHandler receives the text of a streamed answer as it comes in, one piece at a time.
+type TextStreamHandler func(text string)
+
+func AskGlossary(ctx context.Context, collibraHttpClient *http.Client, toolRequest ToolRequest, onText TextStreamHandler) (*ToolResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Data glossary agent query: '%s' (history: %d messages)", toolRequest.Message.Content.Text, len(toolRequest.History)))
+	return callTool(ctx, collibraHttpClient, "/rest/aiCopilot/v1/tools/askGlossary", toolRequest, onText)
 }
 
-func AskDad(ctx context.Context, collibraHttpClient *http.Client, question string) (string, error) {
-	slog.InfoContext(ctx, fmt.Sprintf("Data asset agent query: '%s'", question))
-	return callTool(ctx, collibraHttpClient, "/rest/aiCopilot/v1/tools/askDad", question)
+func AskDad(ctx context.Context, collibraHttpClient *http.Client, toolRequest ToolRequest, onText TextStreamHandler) (*ToolResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Data asset agent query: '%s' (history: %d messages)", toolRequest.Message.Content.Text, len(toolRequest.History)))
+	return callTool(ctx, collibraHttpClient, "/rest/aiCopilot/v1/tools/askDad", toolRequest, onText)
 }
 
-func callTool(ctx context.Context, collibraHttpClient *http.Client, endpoint string, question string) (string, error) {
-	toolRequest := createToolRequest(question)
+// ProbeCopilot tells whether the AI Copilot is available on the Collibra instance. The Copilot endpoints are only
+// served when the Copilot is licensed and enabled, so an empty question that is answered or rejected as invalid means
+// it is available, and not found or forbidden means it is not. Server errors leave it unknown and are returned as errors.
+func ProbeCopilot(ctx context.Context, collibraHttpClient *http.Client) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", "/rest/aiCopilot/v1/tools/askGlossary", strings.NewReader("{}"))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := collibraHttpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to make request: %w", err)
+	}
+	_ = response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusNotFound, response.StatusCode == http.StatusForbidden, response.StatusCode == http.StatusNotImplemented:
+		return false, nil
+	case response.StatusCode == http.StatusUnauthorized:
+		return false, fmt.Errorf("not authenticated")
+	case response.StatusCode >= 200 && response.StatusCode < 500:
+		return true, nil
+	default:
+		return false, fmt.Errorf("HTTP %d", response.StatusCode)
+	}
+}
 
+// callTool asks a Copilot agent. When the endpoint streams its answer as server-sent events, the text is passed to
+// onText as it comes in; otherwise onText is not called. onText may be nil.
+func callTool(ctx context.Context, collibraHttpClient *http.Client, endpoint string, toolRequest ToolRequest, onText TextStreamHandler) (*ToolResponse, error) {
 	jsonData, err := json.Marshal(toolRequest)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal JSON: %w", err)
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	req.Header.Set("Accept", "text/event-stream, application/json")
 
 	response, err := collibraHttpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer func(Body io.ReadCloser) {
 		_ = Body.Close()
 	}(response.Body)
 
+	if response.StatusCode >= 200 && response.StatusCode < 300 && strings.HasPrefix(response.Header.Get("Content-Type"), "text/event-stream") {
+		toolResponse, err := readToolResponseStream(response.Body, onText)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response stream: %w", err)
+		}
+		if len(toolResponse.Content) == 0 {
+			return nil, fmt.Errorf("empty response content")
+		}
+		return toolResponse, nil
+	}
+
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	if response.StatusCode < 200 || response.StatusCode >= 300 { // TODO: ToolHandle 400 and 500 errors.
-		return "", fmt.Errorf("HTTP %d: %s", response.StatusCode, string(body))
+		return nil, fmt.Errorf("HTTP %d: %s", response.StatusCode, string(body))
 	}
 
 	toolResponse, err := unmarshalToolResponse(body)
 	if err != nil {
-		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
 	if len(toolResponse.Content) == 0 {
-		return "", fmt.Errorf("empty response content")
+		return nil, fmt.Errorf("empty response content")
 	}
 
-	return toolResponse.Content[0].Text, nil
+	return toolResponse, nil
+}
+
+// readToolResponseStream reads a streamed answer. Each event carries a chunk shaped like a ToolResponse; text parts
+// continue the text part before them, other parts are added as they are. The stream ends with a [DONE] event or when
+// the body is closed. An error event aborts it.
+func readToolResponseStream(body io.Reader, onText TextStreamHandler) (*ToolResponse, error) {
+	toolResponse := &ToolResponse{Content: []ToolContent{}}
+	handleEvent := func(event string, data string) (bool, error) {
+		if data == "" {
+			return false, nil
+		}
+		if data == "[DONE]" {
+			return true, nil
+		}
+		if event == "error" {
+			return true, fmt.Errorf("agent error: %s", data)
+		}
+		chunk, err := unmarshalToolResponse([]byte(data))
+		if err != nil {
+			return true, err
+		}
+		for _, content := range chunk.Content {
+			isText := content.Type == "" || content.Type == TextToolContentType
+			last := len(toolResponse.Content) - 1
+			if isText && last >= 0 && toolResponse.Content[last].Type == TextToolContentType {
+				toolResponse.Content[last].Text += content.Text
+			} else {
+				if isText {
+					content.Type = TextToolContentType
+				}
+				toolResponse.Content = append(toolResponse.Content, content)
+			}
+			if isText && content.Text != "" && onText != nil {
+				onText(content.Text)
+			}
+		}
+		return false, nil
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	var event string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			done, err := handleEvent(event, strings.Join(data, "\n"))
+			if err != nil || done {
+				return toolResponse, err
+			}
+			event, data = "", nil
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if _, err := handleEvent(event, strings.Join(data, "\n")); err != nil {
+		return nil, err
+	}
+	return toolResponse, nil
 }
 
-func createToolRequest(question string) ToolRequest {
+// NewToolRequest creates a request asking the question after the earlier messages of the conversation. The origin URL
+// is the page the question is asked from, which the agent uses for grounding; it may be empty.
+func NewToolRequest(question string, history []ToolMessage, originUrl string) ToolRequest {
+	if history == nil {
+		history = []ToolMessage{}
+	}
 	return ToolRequest{
-		Message: ToolMessage{
-			MessagerRole: "user",
-			Content:      ToolContent{Type: "text", Text: question},
-		},
-		History: []ToolMessage{},
+		Message: NewToolMessage(UserMessagerRole, question, originUrl),
+		History: history,
+	}
+}
+
+func NewToolMessage(role string, text string, originUrl string) ToolMessage {
+	return ToolMessage{
+		MessagerRole: role,
+		Content:      ToolContent{Type: "text", Text: text},
+		Context:      ChatContext{OriginUrl: originUrl},
 	}
 }
 
@@ -80,9 +206,20 @@ func unmarshalToolResponse(body []byte) (*ToolResponse, error) {
 	return &toolResponse, nil
 }
 
+const (
+	TextToolContentType  = "text"
+	AssetToolContentType = "asset"
+	LinkToolContentType  = "link"
+)
+
+// ToolContent is a part of a message. Text parts carry the answer itself; asset and link parts reference what the
+// answer is based on.
 type ToolContent struct {
 	Type string `json:"type"`
 	Text string `json:"text"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
 }
 
 type ChatContext struct {
@@ -103,3 +240,81 @@ type ToolRequest struct {
 type ToolResponse struct {
 	Content []ToolContent `json:"content"`
 }
+
+// ToolReference is an asset or link cited by a response. AssetID is empty for links to anything but an asset page.
+type ToolReference struct {
+	AssetID string
+	Name    string
+	Url     string
+}
+
+var (
+	markdownLinkPattern = regexp.MustCompile(`\[([^\]]*)\]\((\S+?)\)`)
+	assetUrlPattern     = regexp.MustCompile(`https?://[^\s)\]"'<>]*/asset/([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+)
+
+// Text returns the text parts of the response, in order. Parts without a type are taken to be text.
+func (r *ToolResponse) Text() string {
+	var texts []string
+	for _, content := range r.Content {
+		if (content.Type == "" || content.Type == TextToolContentType) && content.Text != "" {
+			texts = append(texts, content.Text)
+		}
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// References returns the assets and links cited by the response: the asset and link parts, followed by the asset
+// pages linked to from the text. Each asset or link is returned once.
+func (r *ToolResponse) References() []ToolReference {
+	references := []ToolReference{}
+	seen := map[string]bool{}
+	add := func(reference ToolReference) {
+		if reference.AssetID == "" {
+			if match := assetUrlPattern.FindStringSubmatch(reference.Url); match != nil {
+				reference.AssetID = match[1]
+			}
+		}
+		key := reference.AssetID
+		if key == "" {
+			key = reference.Url
+		}
+		if key == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		references = append(references, reference)
+	}
+
+	for _, content := range r.Content {
+		switch content.Type {
+		case AssetToolContentType:
+			add(ToolReference{AssetID: content.ID, Name: firstNonEmpty(content.Name, content.Text), Url: content.Url})
+		case LinkToolContentType:
+			add(ToolReference{Name: firstNonEmpty(content.Name, content.Text), Url: content.Url})
+		}
+	}
+	for _, content := range r.Content {
+		if content.Type != "" && content.Type != TextToolContentType {
+			continue
+		}
+		for _, match := range markdownLinkPattern.FindAllStringSubmatch(content.Text, -1) {
+			if assetUrlPattern.MatchString(match[2]) {
+				add(ToolReference{Name: match[1], Url: match[2]})
+			}
+		}
+		for _, match := range assetUrlPattern.FindAllString(content.Text, -1) {
+			add(ToolReference{Url: match})
+		}
+	}
+	return references
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
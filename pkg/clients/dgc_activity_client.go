@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// ActivityPagedResponse represents the response from the Collibra activities API
+type ActivityPagedResponse struct {
+	Total   int64      `json:"total"`
+	Offset  int64      `json:"offset"`
+	Limit   int64      `json:"limit"`
+	Results []Activity `json:"results"`
+}
+
+type Activity struct {
+	ID                    string `json:"id"`
+	Timestamp             int64  `json:"timestamp"`
+	ActivityType          string `json:"activityType"`
+	ResourceDiscriminator string `json:"resourceDiscriminator,omitempty"`
+	UserID                string `json:"userId,omitempty"`
+	CallID                string `json:"callId,omitempty"`
+	Description           string `json:"description,omitempty"`
+}
+
+// ActivityDescription is the change recorded in the description of an activity
+type ActivityDescription struct {
+	Field    string `json:"field,omitempty"`
+	Type     string `json:"type,omitempty"`
+	OldValue any    `json:"oldValue,omitempty"`
+	NewValue any    `json:"newValue,omitempty"`
+}
+
+type ActivitiesQueryParams struct {
+	ContextID      string `url:"contextId,omitempty"`
+	StartTimestamp int64  `url:"startTimestamp,omitempty"`
+	EndTimestamp   int64  `url:"endTimestamp,omitempty"`
+	Limit          int    `url:"limit,omitempty"`
+	Offset         int    `url:"offset,omitempty"`
+}
+
+func FindActivities(ctx context.Context, collibraHttpClient *http.Client, params ActivitiesQueryParams) (*ActivityPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Finding activities for resource: %s", params.ContextID))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/activities", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ActivityPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse activities response: %w", err)
+	}
+	return &response, nil
+}
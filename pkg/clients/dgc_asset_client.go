@@ -0,0 +1,237 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// AssetPagedResponse represents the response from the Collibra assets API
+type AssetPagedResponse struct {
+	Total   int64           `json:"total"`
+	Offset  int64           `json:"offset"`
+	Limit   int64           `json:"limit"`
+	Results []AssetResource `json:"results"`
+}
+
+type AssetResource struct {
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	DisplayName string                  `json:"displayName,omitempty"`
+	Type        NamedResourceReference  `json:"type"`
+	Domain      NamedResourceReference  `json:"domain"`
+	Status      *NamedResourceReference `json:"status,omitempty"`
+}
+
+type AssetsQueryParams struct {
+	Name          string   `url:"name,omitempty"`
+	NameMatchMode string   `url:"nameMatchMode,omitempty"`
+	TypeIDs       []string `url:"typeIds,omitempty"`
+	DomainID      string   `url:"domainId,omitempty"`
+	Limit         int      `url:"limit,omitempty"`
+	Offset        int      `url:"offset,omitempty"`
+}
+
+type AddAssetRequest struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	DomainID    string `json:"domainId"`
+	TypeID      string `json:"typeId"`
+	StatusID    string `json:"statusId,omitempty"`
+}
+
+type ChangeAssetRequest struct {
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	StatusID    string `json:"statusId,omitempty"`
+}
+
+// AttributePagedResponse represents the response from the Collibra attributes API
+type AttributePagedResponse struct {
+	Total   int64               `json:"total"`
+	Offset  int64               `json:"offset"`
+	Limit   int64               `json:"limit"`
+	Results []AttributeResource `json:"results"`
+}
+
+type AttributeResource struct {
+	ID    string                 `json:"id"`
+	Type  NamedResourceReference `json:"type"`
+	Asset NamedResourceReference `json:"asset"`
+	Value any                    `json:"value"`
+}
+
+type AttributesQueryParams struct {
+	AssetID string   `url:"assetId,omitempty"`
+	TypeIDs []string `url:"typeIds,omitempty"`
+	Limit   int      `url:"limit,omitempty"`
+}
+
+type AddAttributeRequest struct {
+	AssetID string `json:"assetId"`
+	TypeID  string `json:"typeId"`
+	Value   any    `json:"value"`
+}
+
+type ChangeAttributeRequest struct {
+	Value any `json:"value"`
+}
+
+// RelationPagedResponse represents the response from the Collibra relations API
+type RelationPagedResponse struct {
+	Total   int64              `json:"total"`
+	Offset  int64              `json:"offset"`
+	Limit   int64              `json:"limit"`
+	Results []RelationResource `json:"results"`
+}
+
+type RelationResource struct {
+	ID     string                 `json:"id"`
+	Type   NamedResourceReference `json:"type"`
+	Source NamedResourceReference `json:"source"`
+	Target NamedResourceReference `json:"target"`
+}
+
+type RelationsQueryParams struct {
+	SourceID       string `url:"sourceId,omitempty"`
+	TargetID       string `url:"targetId,omitempty"`
+	RelationTypeID string `url:"relationTypeId,omitempty"`
+	Limit          int    `url:"limit,omitempty"`
+}
+
+type AddRelationRequest struct {
+	SourceID string `json:"sourceId"`
+	TargetID string `json:"targetId"`
+	TypeID   string `json:"typeId"`
+}
+
+func FindAssets(ctx context.Context, collibraHttpClient *http.Client, params AssetsQueryParams) (*AssetPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Finding assets with name: '%s'", params.Name))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/assets", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AssetPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse assets response: %w", err)
+	}
+	return &response, nil
+}
+
+func GetAsset(ctx context.Context, collibraHttpClient *http.Client, assetID string) (*AssetResource, error) {
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/2.0/assets/%s", assetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var asset AssetResource
+	if err := json.Unmarshal(body, &asset); err != nil {
+		return nil, fmt.Errorf("failed to parse asset response: %w", err)
+	}
+	return &asset, nil
+}
+
+func AddAsset(ctx context.Context, collibraHttpClient *http.Client, request AddAssetRequest) (*AssetResource, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding asset '%s' to domain: %s", request.Name, request.DomainID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/assets", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var asset AssetResource
+	if err := json.Unmarshal(body, &asset); err != nil {
+		return nil, fmt.Errorf("failed to parse asset response: %w", err)
+	}
+	return &asset, nil
+}
+
+func ChangeAsset(ctx context.Context, collibraHttpClient *http.Client, assetID string, request ChangeAssetRequest) (*AssetResource, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Changing asset: %s", assetID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "PATCH", fmt.Sprintf("/rest/2.0/assets/%s", assetID), request)
+	if err != nil {
+		return nil, err
+	}
+
+	var asset AssetResource
+	if err := json.Unmarshal(body, &asset); err != nil {
+		return nil, fmt.Errorf("failed to parse asset response: %w", err)
+	}
+	return &asset, nil
+}
+
+func FindAttributes(ctx context.Context, collibraHttpClient *http.Client, params AttributesQueryParams) (*AttributePagedResponse, error) {
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/attributes", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AttributePagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse attributes response: %w", err)
+	}
+	return &response, nil
+}
+
+func AddAttribute(ctx context.Context, collibraHttpClient *http.Client, request AddAttributeRequest) (*AttributeResource, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding attribute of type '%s' to asset: %s", request.TypeID, request.AssetID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/attributes", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var attribute AttributeResource
+	if err := json.Unmarshal(body, &attribute); err != nil {
+		return nil, fmt.Errorf("failed to parse attribute response: %w", err)
+	}
+	return &attribute, nil
+}
+
+func ChangeAttribute(ctx context.Context, collibraHttpClient *http.Client, attributeID string, request ChangeAttributeRequest) (*AttributeResource, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Changing attribute: %s", attributeID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "PATCH", fmt.Sprintf("/rest/2.0/attributes/%s", attributeID), request)
+	if err != nil {
+		return nil, err
+	}
+
+	var attribute AttributeResource
+	if err := json.Unmarshal(body, &attribute); err != nil {
+		return nil, fmt.Errorf("failed to parse attribute response: %w", err)
+	}
+	return &attribute, nil
+}
+
+func FindRelations(ctx context.Context, collibraHttpClient *http.Client, params RelationsQueryParams) (*RelationPagedResponse, error) {
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/relations", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RelationPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse relations response: %w", err)
+	}
+	return &response, nil
+}
+
+func AddRelation(ctx context.Context, collibraHttpClient *http.Client, request AddRelationRequest) (*RelationResource, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding relation of type '%s' from %s to %s", request.TypeID, request.SourceID, request.TargetID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/relations", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var relation RelationResource
+	if err := json.Unmarshal(body, &relation); err != nil {
+		return nil, fmt.Errorf("failed to parse relation response: %w", err)
+	}
+	return &relation, nil
+}
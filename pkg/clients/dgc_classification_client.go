@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -46,16 +47,49 @@ type DataClass struct {
 	Rules               []json.RawMessage `json:"rules"`
 }
 
+const (
+	DataClassRuleTypeRegex      = "REGEX"
+	DataClassRuleTypeColumnName = "COLUMN_NAME"
+	DataClassRuleTypeDictionary = "DICTIONARY"
+)
+
+// DataClassRule is a rule of a data class. A REGEX rule matches column values against Pattern, a COLUMN_NAME rule
+// matches the column name against Pattern and a DICTIONARY rule matches column values against Values.
+type DataClassRule struct {
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Type          string   `json:"type"`
+	Pattern       string   `json:"pattern,omitempty"`
+	Values        []string `json:"values,omitempty"`
+	CaseSensitive bool     `json:"caseSensitive,omitempty"`
+}
+
 type AddDataClassRequest struct {
-	Name                string   `json:"name"`
-	Description         string   `json:"description,omitempty"`
-	Status              string   `json:"status,omitempty"`
-	ColumnNameFilters   []string `json:"columnNameFilters,omitempty"`
-	ColumnTypeFilters   []string `json:"columnTypeFilters,omitempty"`
-	AllowNullValues     *bool    `json:"allowNullValues,omitempty"`
-	AllowEmptyValues    *bool    `json:"allowEmptyValues,omitempty"`
-	ConfidenceThreshold *int     `json:"confidenceThreshold,omitempty"`
-	Examples            []string `json:"examples,omitempty"`
+	Name                string          `json:"name"`
+	Description         string          `json:"description,omitempty"`
+	Status              string          `json:"status,omitempty"`
+	ColumnNameFilters   []string        `json:"columnNameFilters,omitempty"`
+	ColumnTypeFilters   []string        `json:"columnTypeFilters,omitempty"`
+	AllowNullValues     *bool           `json:"allowNullValues,omitempty"`
+	AllowEmptyValues    *bool           `json:"allowEmptyValues,omitempty"`
+	ConfidenceThreshold *int            `json:"confidenceThreshold,omitempty"`
+	Examples            []string        `json:"examples,omitempty"`
+	Rules               []DataClassRule `json:"rules,omitempty"`
+}
+
+// ChangeDataClassRequest changes the fields of a data class that are set. Rules, when set, replace all rules of the
+// data class.
+type ChangeDataClassRequest struct {
+	Name                *string         `json:"name,omitempty"`
+	Description         *string         `json:"description,omitempty"`
+	Status              *string         `json:"status,omitempty"`
+	ColumnNameFilters   []string        `json:"columnNameFilters,omitempty"`
+	ColumnTypeFilters   []string        `json:"columnTypeFilters,omitempty"`
+	AllowNullValues     *bool           `json:"allowNullValues,omitempty"`
+	AllowEmptyValues    *bool           `json:"allowEmptyValues,omitempty"`
+	ConfidenceThreshold *int            `json:"confidenceThreshold,omitempty"`
+	Examples            []string        `json:"examples,omitempty"`
+	Rules               []DataClassRule `json:"rules,omitempty"`
 }
 
 func SearchDataClasses(ctx context.Context, collibraHttpClient *http.Client, params DataClassQueryParams) ([]DataClass, int, error) {
@@ -70,6 +104,34 @@ func SearchDataClasses(ctx context.Context, collibraHttpClient *http.Client, par
 	return parseDataClasses(body)
 }
 
+func GetDataClass(ctx context.Context, collibraHttpClient *http.Client, dataClassID string) (*DataClass, error) {
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/classification/v1/dataClasses/%s", dataClassID), nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseDataClass(body)
+}
+
+func AddDataClass(ctx context.Context, collibraHttpClient *http.Client, request AddDataClassRequest) (*DataClass, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding data class '%s' with %d rules", request.Name, len(request.Rules)))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/classification/v1/dataClasses", request)
+	if err != nil {
+		return nil, err
+	}
+	return parseDataClass(body)
+}
+
+func ChangeDataClass(ctx context.Context, collibraHttpClient *http.Client, dataClassID string, request ChangeDataClassRequest) (*DataClass, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Changing data class: %s", dataClassID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "PATCH", fmt.Sprintf("/rest/classification/v1/dataClasses/%s", dataClassID), request)
+	if err != nil {
+		return nil, err
+	}
+	return parseDataClass(body)
+}
+
 func fetchJSON(method string, endpoint string, ctx context.Context, httpClient *http.Client) ([]byte, int, error) {
 	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if err != nil {
@@ -114,3 +176,11 @@ func parseDataClasses(body []byte) ([]DataClass, int, error) {
 	}
 	return dcResp.Results, dcResp.Total, nil
 }
+
+func parseDataClass(body []byte) (*DataClass, error) {
+	var dataClass DataClass
+	if err := json.Unmarshal(body, &dataClass); err != nil {
+		return nil, fmt.Errorf("failed to parse data class response: %w", err)
+	}
+	return &dataClass, nil
+}
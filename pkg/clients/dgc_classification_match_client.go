@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,6 +12,11 @@ import (
 	"github.com/google/go-querystring/query"
 )
 
+var (
+	ErrClassificationMatchExists   = errors.New("classification match already exists")
+	ErrClassificationMatchNotFound = errors.New("classification match not found")
+)
+
 type AddDataClassificationMatchRequest struct {
 	AssetID          string `json:"assetId"`
 	ClassificationID string `json:"classificationId"`
@@ -88,7 +94,46 @@ func AddDataClassificationMatch(ctx context.Context, httpClient *http.Client, re
 	}
 
 	if resp.StatusCode == 422 {
-		return nil, fmt.Errorf("classification match already exists between this asset and classification (HTTP 422): %s", string(body))
+		return nil, fmt.Errorf("%w between this asset and classification (HTTP 422): %s", ErrClassificationMatchExists, string(body))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("http %d: %s", resp.StatusCode, string(body))
+	}
+
+	var match DataClassificationMatch
+	if err := json.Unmarshal(body, &match); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &match, nil
+}
+
+func GetDataClassificationMatch(ctx context.Context, httpClient *http.Client, classificationMatchID string) (*DataClassificationMatch, error) {
+	endpoint := fmt.Sprintf("/rest/catalog/1.0/dataClassification/classificationMatches/%s", classificationMatchID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == 404 {
+		return nil, ErrClassificationMatchNotFound
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -165,7 +210,7 @@ func RemoveDataClassificationMatch(ctx context.Context, httpClient *http.Client,
 	}(resp.Body)
 
 	if resp.StatusCode == 404 {
-		return fmt.Errorf("classification match not found")
+		return ErrClassificationMatchNotFound
 	}
 
 	if resp.StatusCode != 204 {
@@ -178,3 +223,50 @@ func RemoveDataClassificationMatch(ctx context.Context, httpClient *http.Client,
 
 	return nil
 }
+
+func AcceptDataClassificationMatch(ctx context.Context, httpClient *http.Client, classificationMatchID string) (*DataClassificationMatch, error) {
+	return reviewDataClassificationMatch(ctx, httpClient, classificationMatchID, "accept")
+}
+
+func RejectDataClassificationMatch(ctx context.Context, httpClient *http.Client, classificationMatchID string) (*DataClassificationMatch, error) {
+	return reviewDataClassificationMatch(ctx, httpClient, classificationMatchID, "reject")
+}
+
+func reviewDataClassificationMatch(ctx context.Context, httpClient *http.Client, classificationMatchID string, decision string) (*DataClassificationMatch, error) {
+	endpoint := fmt.Sprintf("/rest/catalog/1.0/dataClassification/classificationMatches/%s/%s", classificationMatchID, decision)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == 404 {
+		return nil, ErrClassificationMatchNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("http %d: %s", resp.StatusCode, string(body))
+	}
+
+	var match DataClassificationMatch
+	if err := json.Unmarshal(body, &match); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &match, nil
+}
@@ -10,15 +10,12 @@ import (
 	"net/http"
 
 	"github.com/google/go-querystring/query"
-	"github.com/google/uuid"
 )
 
-func SearchKeyword(ctx context.Context, collibraHttpClient *http.Client, question string, resourceTypes []string, filters []SearchFilter, limit int, offset int) (*SearchResponse, error) {
-	slog.InfoContext(ctx, fmt.Sprintf("Keyword search query: '%s'", question))
+func SearchKeyword(ctx context.Context, collibraHttpClient *http.Client, searchRequest SearchRequest) (*SearchResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Keyword search query: '%s'", searchRequest.Keywords))
 	searchUrl := "/rest/2.0/search"
 
-	searchRequest := CreateSearchRequest(question, resourceTypes, filters, limit, offset)
-
 	jsonData, err := json.Marshal(searchRequest)
 	slog.InfoContext(ctx, fmt.Sprintf("Search request: %s", string(jsonData)))
 	if err != nil {
@@ -38,19 +35,9 @@ func SearchKeyword(ctx context.Context, collibraHttpClient *http.Client, questio
 	return ParseSearchResponse(body)
 }
 
-func GetAssetSummary(
-	ctx context.Context,
-	collibraHttpClient *http.Client,
-	uuid uuid.UUID,
-	outgoingRelationsCursor string,
-	incomingRelationsCursor string,
-) ([]Asset, error) {
+func GetAssetSummary(ctx context.Context, collibraHttpClient *http.Client, request AssetDetailsRequest) ([]Asset, error) {
 	gqlUrl := "/graphql/knowledgeGraph/v1"
-	gqlRequest := CreateAssetDetailsGraphQLQuery(
-		[]string{uuid.String()},
-		outgoingRelationsCursor,
-		incomingRelationsCursor,
-	)
+	gqlRequest := CreateAssetDetailsGraphQLQuery(request)
 
 	jsonData, err := json.Marshal(gqlRequest)
 	if err != nil {
@@ -70,6 +57,28 @@ func GetAssetSummary(
 	return ParseAssetDetailsGraphQLResponse(body)
 }
 
+func GetAssetDetailsBatch(ctx context.Context, collibraHttpClient *http.Client, requests []AssetDetailsRequest) (*BatchAssetDetailsResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Getting details of %d assets", len(requests)))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/graphql/knowledgeGraph/v1", CreateBatchAssetDetailsGraphQLQuery(requests))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseBatchAssetDetailsGraphQLResponse(body)
+}
+
+func GetAssetsOverview(ctx context.Context, collibraHttpClient *http.Client, assetIDs []string) ([]Asset, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Getting overview of %d assets", len(assetIDs)))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/graphql/knowledgeGraph/v1", CreateAssetOverviewGraphQLQuery(assetIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseAssetDetailsGraphQLResponse(body)
+}
+
 func ListAssetTypes(ctx context.Context, collibraHttpClient *http.Client, limit int, offset int) (*AssetTypePagedResponse, error) {
 	slog.InfoContext(ctx, fmt.Sprintf("Listing asset types with limit: %d, offset: %d", limit, offset))
 
@@ -180,6 +189,36 @@ func buildUrl(basePath string, params interface{}) (string, error) {
 	return basePath, nil
 }
 
+func getJSON(ctx context.Context, collibraHttpClient *http.Client, basePath string, params interface{}) ([]byte, error) {
+	endpoint, err := buildUrl(basePath, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build endpoint: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return executeRequest(collibraHttpClient, req)
+}
+
+func sendJSON(ctx context.Context, collibraHttpClient *http.Client, method string, endpoint string, payload interface{}) ([]byte, error) {
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	return executeRequest(collibraHttpClient, req)
+}
+
 func executeRequest(client *http.Client, req *http.Request) ([]byte, error) {
 	response, err := client.Do(req)
 	if err != nil {
@@ -0,0 +1,70 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// CommentPagedResponse represents the response from the Collibra comments API
+type CommentPagedResponse struct {
+	Total   int64     `json:"total"`
+	Offset  int64     `json:"offset"`
+	Limit   int64     `json:"limit"`
+	Results []Comment `json:"results"`
+}
+
+type Comment struct {
+	ID             string                  `json:"id"`
+	Content        string                  `json:"content"`
+	CreatedBy      string                  `json:"createdBy"`
+	CreatedOn      int64                   `json:"createdOn"`
+	LastModifiedOn int64                   `json:"lastModifiedOn"`
+	BaseResource   NamedResourceReference  `json:"baseResource"`
+	Parent         *NamedResourceReference `json:"parent,omitempty"`
+}
+
+type CommentsQueryParams struct {
+	BaseResourceID string `url:"baseResourceId,omitempty"`
+	SortOrder      string `url:"sortOrder,omitempty"`
+	Limit          int    `url:"limit,omitempty"`
+	Offset         int    `url:"offset,omitempty"`
+}
+
+type AddCommentRequest struct {
+	Content      string                 `json:"content"`
+	BaseResource NamedResourceReference `json:"baseResource"`
+	ParentID     string                 `json:"parentId,omitempty"`
+}
+
+func FindComments(ctx context.Context, collibraHttpClient *http.Client, params CommentsQueryParams) (*CommentPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Finding comments on resource: %s", params.BaseResourceID))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/comments", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CommentPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse comments response: %w", err)
+	}
+	return &response, nil
+}
+
+func AddComment(ctx context.Context, collibraHttpClient *http.Client, request AddCommentRequest) (*Comment, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding comment on resource: %s", request.BaseResource.ID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/comments", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := json.Unmarshal(body, &comment); err != nil {
+		return nil, fmt.Errorf("failed to parse comment response: %w", err)
+	}
+	return &comment, nil
+}
@@ -3,6 +3,8 @@ package clients
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 )
 
 const (
@@ -10,40 +12,124 @@ const (
 	RelationsLimit  = 50
 )
 
-func CreateAssetDetailsGraphQLQuery(
-	assetIds []string,
-	outgoingRelationsCursor string,
-	incomingRelationsCursor string,
-) Request {
-	variables := map[string]interface{}{
-		"assetIds":        assetIds,
-		"attributesLimit": AttributesLimit,
-		"relationsLimit":  RelationsLimit,
-	}
+const (
+	SectionAttributes        = "attributes"
+	SectionOutgoingRelations = "outgoingRelations"
+	SectionIncomingRelations = "incomingRelations"
 
-	// Build query parameters list
-	queryParams := "$assetIds: [UUID!]!, $attributesLimit: Int!, $relationsLimit: Int!"
+	SectionStringAttributes  = "stringAttributes"
+	SectionNumericAttributes = "numericAttributes"
+	SectionBooleanAttributes = "booleanAttributes"
+	SectionDateAttributes    = "dateAttributes"
+)
 
-	// Build outgoing relations clause
-	outgoingClause := "outgoingRelations(order: { id: asc }, limit: $relationsLimit)"
-	if outgoingRelationsCursor != "" {
-		queryParams += ", $outgoingCursor: UUID!"
-		outgoingClause = "outgoingRelations(order: { id: asc }, where: { id: { gt: $outgoingCursor } }, limit: $relationsLimit)"
-		variables["outgoingCursor"] = outgoingRelationsCursor
-	}
+var AssetDetailsSections = []string{SectionAttributes, SectionOutgoingRelations, SectionIncomingRelations}
+
+// AttributeSections are the sections of the attribute kinds, which together make up the attributes section
+var AttributeSections = []string{SectionStringAttributes, SectionNumericAttributes, SectionBooleanAttributes, SectionDateAttributes}
+
+// AssetDetailsSelection narrows down what is fetched of an asset. The zero value fetches every section with the default limits.
+type AssetDetailsSelection struct {
+	Sections               []string
+	AttributeTypeIDs       []string
+	RelationTypeIDs        []string
+	AttributesLimit        int
+	OutgoingRelationsLimit int
+	IncomingRelationsLimit int
+}
 
-	// Build incoming relations clause
-	incomingClause := "incomingRelations(order: { id: asc }, limit: $relationsLimit)"
-	if incomingRelationsCursor != "" {
-		queryParams += ", $incomingCursor: UUID!"
-		incomingClause = "incomingRelations(order: { id: asc }, where: { id: { gt: $incomingCursor } }, limit: $relationsLimit)"
-		variables["incomingCursor"] = incomingRelationsCursor
+func (s AssetDetailsSelection) Includes(section string) bool {
+	if len(s.Sections) == 0 || slices.Contains(s.Sections, section) {
+		return true
 	}
+	return slices.Contains(AttributeSections, section) && slices.Contains(s.Sections, SectionAttributes)
+}
+
+// AssetDetailsRequest identifies an asset together with its selection and the cursors to page its sections.
+// Cursors are keyed by section (e.g. outgoingRelations, stringAttributes) and hold the ID of the last item already fetched.
+type AssetDetailsRequest struct {
+	AssetID   string
+	Cursors   map[string]string
+	Selection AssetDetailsSelection
+}
+
+func CreateAssetDetailsGraphQLQuery(request AssetDetailsRequest) Request {
+	builder := newAssetQueryBuilder()
+	assetIds := builder.variable("", "assetIds", "[UUID!]!", []string{request.AssetID})
+	fields := builder.assetFields("", request)
 
 	query := fmt.Sprintf(`
 query GetAssetDetails(%s) {
-  assets(where: { id: { in: $assetIds } }) {
-    id
+  assets(where: { id: { in: %s } }) {
+%s  }
+}
+`, strings.Join(builder.params, ", "), assetIds, fields)
+
+	return Request{
+		Query:     query,
+		Variables: builder.variables,
+	}
+}
+
+// CreateBatchAssetDetailsGraphQLQuery fetches each asset under its own alias (asset0, asset1, ...) so that
+// relations are paged and errors are reported per asset.
+func CreateBatchAssetDetailsGraphQLQuery(requests []AssetDetailsRequest) Request {
+	builder := newAssetQueryBuilder()
+
+	var selections strings.Builder
+	for i, request := range requests {
+		alias := BatchAssetAlias(i)
+		assetId := builder.variable(alias, "id", "UUID!", request.AssetID)
+		fmt.Fprintf(&selections, "  %s: assets(where: { id: { eq: %s } }) {\n%s  }\n", alias, assetId, builder.assetFields(alias, request))
+	}
+
+	query := fmt.Sprintf("\nquery GetAssetDetailsBatch(%s) {\n%s}\n", strings.Join(builder.params, ", "), selections.String())
+
+	return Request{
+		Query:     query,
+		Variables: builder.variables,
+	}
+}
+
+func BatchAssetAlias(index int) string {
+	return fmt.Sprintf("asset%d", index)
+}
+
+var attributeKinds = []struct {
+	section string
+	value   string
+}{
+	{SectionStringAttributes, "stringValue"},
+	{SectionNumericAttributes, "numericValue"},
+	{SectionBooleanAttributes, "booleanValue"},
+	{SectionDateAttributes, "dateValue"},
+}
+
+// assetQueryBuilder collects the variables of a query while its asset selections are rendered.
+type assetQueryBuilder struct {
+	params    []string
+	variables map[string]interface{}
+}
+
+func newAssetQueryBuilder() *assetQueryBuilder {
+	return &assetQueryBuilder{variables: map[string]interface{}{}}
+}
+
+// variable declares a query variable, prefixed to keep the variables of aliased assets apart, and returns its reference.
+func (b *assetQueryBuilder) variable(prefix string, name string, graphQLType string, value interface{}) string {
+	if prefix != "" {
+		name = prefix + strings.ToUpper(name[:1]) + name[1:]
+	}
+	b.params = append(b.params, fmt.Sprintf("$%s: %s", name, graphQLType))
+	b.variables[name] = value
+	return "$" + name
+}
+
+func (b *assetQueryBuilder) assetFields(prefix string, request AssetDetailsRequest) string {
+	selection := request.Selection
+
+	var fields strings.Builder
+	fields.WriteString(`    id
     displayName
     type {
       name
@@ -54,36 +140,54 @@ query GetAssetDetails(%s) {
     status {
       name
     }
-    stringAttributes(limit: $attributesLimit) {
-      stringValue
-      type {
-        name
-      }
-    }
-    numericAttributes(limit: $attributesLimit) {
-      numericValue
-      type {
-        name
-      }
-    }
-    booleanAttributes(limit: $attributesLimit) {
-      booleanValue
-      type {
-        name
-      }
-    }
-    dateAttributes(limit: $attributesLimit) {
-      dateValue
+`)
+
+	attributesLimit, attributeTypeIds := "", ""
+	for _, kind := range attributeKinds {
+		if !selection.Includes(kind.section) {
+			continue
+		}
+		if attributesLimit == "" {
+			attributesLimit = b.variable(prefix, "attributesLimit", "Int!", limitOrDefault(selection.AttributesLimit, AttributesLimit))
+			if len(selection.AttributeTypeIDs) > 0 {
+				attributeTypeIds = b.variable(prefix, "attributeTypeIds", "[UUID!]!", selection.AttributeTypeIDs)
+			}
+		}
+
+		fmt.Fprintf(&fields, `    %s(%s) {
+      id
+      %s
       type {
         name
       }
     }
-    %s {
+`, kind.section, b.pageArgs(prefix, kind.section, request.Cursors[kind.section], attributeTypeIds, attributesLimit), kind.value)
+	}
+
+	relationTypeIds := ""
+	if len(selection.RelationTypeIDs) > 0 && (selection.Includes(SectionOutgoingRelations) || selection.Includes(SectionIncomingRelations)) {
+		relationTypeIds = b.variable(prefix, "relationTypeIds", "[UUID!]!", selection.RelationTypeIDs)
+	}
+	for _, direction := range []struct {
+		section string
+		limit   int
+		end     string
+	}{
+		{SectionOutgoingRelations, selection.OutgoingRelationsLimit, "target"},
+		{SectionIncomingRelations, selection.IncomingRelationsLimit, "source"},
+	} {
+		if !selection.Includes(direction.section) {
+			continue
+		}
+		limit := b.variable(prefix, direction.section+"Limit", "Int!", limitOrDefault(direction.limit, RelationsLimit))
+
+		fmt.Fprintf(&fields, `    %s(%s) {
+      id
       type {
         id
         role
       }
-      target {
+      %s {
         id
         displayName
         type {
@@ -91,26 +195,65 @@ query GetAssetDetails(%s) {
         }
       }
     }
-    %s {
-      type {
-        id
-        role
-      }
-      source {
+`, direction.section, b.pageArgs(prefix, direction.section, request.Cursors[direction.section], relationTypeIds, limit), direction.end)
+	}
+
+	return fields.String()
+}
+
+// pageArgs renders the arguments to fetch a page of a section ordered by ID, after the cursor and restricted to the types.
+func (b *assetQueryBuilder) pageArgs(prefix string, section string, cursor string, typeIds string, limit string) string {
+	var conditions []string
+	if cursor != "" {
+		conditions = append(conditions, fmt.Sprintf("id: { gt: %s }", b.variable(prefix, section+"Cursor", "UUID!", cursor)))
+	}
+	if typeIds != "" {
+		conditions = append(conditions, fmt.Sprintf("type: { id: { in: %s } }", typeIds))
+	}
+
+	args := "order: { id: asc }"
+	if len(conditions) > 0 {
+		args += fmt.Sprintf(", where: { %s }", strings.Join(conditions, ", "))
+	}
+	return args + ", limit: " + limit
+}
+
+func limitOrDefault(limit int, defaultLimit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return defaultLimit
+}
+
+// CreateAssetOverviewGraphQLQuery only selects what identifies an asset: its names, type, domain, community and status.
+func CreateAssetOverviewGraphQLQuery(assetIds []string) Request {
+	query := `
+query GetAssetOverview($assetIds: [UUID!]!) {
+  assets(where: { id: { in: $assetIds } }) {
+    id
+    fullName
+    displayName
+    type {
+      name
+    }
+    domain {
+      id
+      name
+      parent {
         id
-        displayName
-        type {
-          name
-        }
+        name
       }
     }
+    status {
+      name
+    }
   }
 }
-`, queryParams, outgoingClause, incomingClause)
+`
 
 	return Request{
 		Query:     query,
-		Variables: variables,
+		Variables: map[string]interface{}{"assetIds": assetIds},
 	}
 }
 
@@ -132,6 +275,36 @@ func ParseAssetDetailsGraphQLResponse(jsonData []byte) ([]Asset, error) {
 	return response.Data.Assets, nil
 }
 
+// BatchAssetDetailsResponse holds the assets per alias of a batch query, with the errors of all aliases
+type BatchAssetDetailsResponse struct {
+	Data   map[string][]Asset `json:"data,omitempty"`
+	Errors []Error            `json:"errors,omitempty"`
+}
+
+// ErrorsFor returns the messages of the errors whose path starts with the alias.
+func (r *BatchAssetDetailsResponse) ErrorsFor(alias string) []string {
+	var messages []string
+	for _, err := range r.Errors {
+		if len(err.Path) > 0 && err.Path[0] == alias {
+			messages = append(messages, err.Message)
+		}
+	}
+	return messages
+}
+
+func ParseBatchAssetDetailsGraphQLResponse(jsonData []byte) (*BatchAssetDetailsResponse, error) {
+	var response BatchAssetDetailsResponse
+	if err := json.Unmarshal(jsonData, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal GraphQL response: %w", err)
+	}
+
+	if response.Data == nil && len(response.Errors) > 0 {
+		return nil, fmt.Errorf("GraphQL errors: %v", response.Errors)
+	}
+
+	return &response, nil
+}
+
 type Request struct {
 	Query     string                 `json:"query"`
 	Variables map[string]interface{} `json:"variables,omitempty"`
@@ -153,6 +326,7 @@ type AssetQueryData struct {
 
 type Asset struct {
 	ID                string             `json:"id"`
+	FullName          string             `json:"fullName,omitempty"`
 	DisplayName       string             `json:"displayName"`
 	Type              *AssetType         `json:"type,omitempty"`
 	Domain            *Domain            `json:"domain,omitempty"`
@@ -170,6 +344,13 @@ type AssetType struct {
 }
 
 type Domain struct {
+	ID     string     `json:"id,omitempty"`
+	Name   string     `json:"name"`
+	Parent *Community `json:"parent,omitempty"`
+}
+
+type Community struct {
+	ID   string `json:"id,omitempty"`
 	Name string `json:"name"`
 }
 
@@ -178,21 +359,25 @@ type Status struct {
 }
 
 type StringAttribute struct {
+	ID    string         `json:"id,omitempty"`
 	Value string         `json:"stringValue"`
 	Type  *AttributeType `json:"type,omitempty"`
 }
 
 type NumericAttribute struct {
+	ID    string         `json:"id,omitempty"`
 	Value float64        `json:"numericValue"`
 	Type  *AttributeType `json:"type,omitempty"`
 }
 
 type BooleanAttribute struct {
+	ID    string         `json:"id,omitempty"`
 	Value bool           `json:"booleanValue"`
 	Type  *AttributeType `json:"type,omitempty"`
 }
 
 type DateAttribute struct {
+	ID    string         `json:"id,omitempty"`
 	Value string         `json:"dateValue"`
 	Type  *AttributeType `json:"type,omitempty"`
 }
@@ -202,11 +387,13 @@ type AttributeType struct {
 }
 
 type OutgoingRelation struct {
+	ID     string        `json:"id,omitempty"`
 	Type   *RelationType `json:"type,omitempty"`
 	Target *RelatedAsset `json:"target,omitempty"`
 }
 
 type IncomingRelation struct {
+	ID     string        `json:"id,omitempty"`
 	Type   *RelationType `json:"type,omitempty"`
 	Source *RelatedAsset `json:"source,omitempty"`
 }
@@ -0,0 +1,258 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StatusPagedResponse represents the response from the Collibra statuses API
+type StatusPagedResponse struct {
+	Total   int64           `json:"total"`
+	Offset  int64           `json:"offset"`
+	Limit   int64           `json:"limit"`
+	Results []StatusDetails `json:"results"`
+}
+
+type StatusDetails struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// DomainTypePagedResponse represents the response from the Collibra domain types API
+type DomainTypePagedResponse struct {
+	Total   int64               `json:"total"`
+	Offset  int64               `json:"offset"`
+	Limit   int64               `json:"limit"`
+	Results []DomainTypeDetails `json:"results"`
+}
+
+type DomainTypeDetails struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	PublicId    string `json:"publicId,omitempty"`
+}
+
+// UserPagedResponse represents the response from the Collibra users API
+type UserPagedResponse struct {
+	Total   int64         `json:"total"`
+	Offset  int64         `json:"offset"`
+	Limit   int64         `json:"limit"`
+	Results []UserDetails `json:"results"`
+}
+
+type UserDetails struct {
+	ID           string `json:"id"`
+	UserName     string `json:"userName"`
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	Enabled      bool   `json:"enabled"`
+}
+
+// FullName returns the first and last name of the user, or the user name if both are empty.
+func (u UserDetails) FullName() string {
+	fullName := strings.TrimSpace(u.FirstName + " " + u.LastName)
+	if fullName == "" {
+		return u.UserName
+	}
+	return fullName
+}
+
+// AttributeTypePagedResponse represents the response from the Collibra attribute types API
+type AttributeTypePagedResponse struct {
+	Total   int64                  `json:"total"`
+	Offset  int64                  `json:"offset"`
+	Limit   int64                  `json:"limit"`
+	Results []AttributeTypeDetails `json:"results"`
+}
+
+type AttributeTypeDetails struct {
+	ID                         string `json:"id"`
+	Name                       string `json:"name"`
+	Description                string `json:"description,omitempty"`
+	PublicId                   string `json:"publicId,omitempty"`
+	Kind                       string `json:"kind,omitempty"`
+	AttributeTypeDiscriminator string `json:"attributeTypeDiscriminator,omitempty"`
+}
+
+// RelationTypePagedResponse represents the response from the Collibra relation types API
+type RelationTypePagedResponse struct {
+	Total   int64                 `json:"total"`
+	Offset  int64                 `json:"offset"`
+	Limit   int64                 `json:"limit"`
+	Results []RelationTypeDetails `json:"results"`
+}
+
+type RelationTypeDetails struct {
+	ID          string                 `json:"id"`
+	Role        string                 `json:"role"`
+	CoRole      string                 `json:"coRole"`
+	Description string                 `json:"description,omitempty"`
+	PublicId    string                 `json:"publicId,omitempty"`
+	SourceType  NamedResourceReference `json:"sourceType"`
+	TargetType  NamedResourceReference `json:"targetType"`
+}
+
+type PagingQueryParams struct {
+	Limit  int `url:"limit,omitempty"`
+	Offset int `url:"offset,omitempty"`
+}
+
+type UsersQueryParams struct {
+	Name   string `url:"name,omitempty"`
+	Limit  int    `url:"limit,omitempty"`
+	Offset int    `url:"offset,omitempty"`
+}
+
+func ListStatuses(ctx context.Context, collibraHttpClient *http.Client, limit int, offset int) (*StatusPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Listing statuses with limit: %d, offset: %d", limit, offset))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/statuses", PagingQueryParams{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+
+	var response StatusPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse statuses response: %w", err)
+	}
+	return &response, nil
+}
+
+func ListDomainTypes(ctx context.Context, collibraHttpClient *http.Client, limit int, offset int) (*DomainTypePagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Listing domain types with limit: %d, offset: %d", limit, offset))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/domainTypes", PagingQueryParams{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+
+	var response DomainTypePagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse domain types response: %w", err)
+	}
+	return &response, nil
+}
+
+func ListAttributeTypes(ctx context.Context, collibraHttpClient *http.Client, limit int, offset int) (*AttributeTypePagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Listing attribute types with limit: %d, offset: %d", limit, offset))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/attributeTypes", PagingQueryParams{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+
+	var response AttributeTypePagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse attribute types response: %w", err)
+	}
+	return &response, nil
+}
+
+func ListRelationTypes(ctx context.Context, collibraHttpClient *http.Client, limit int, offset int) (*RelationTypePagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Listing relation types with limit: %d, offset: %d", limit, offset))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/relationTypes", PagingQueryParams{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+
+	var response RelationTypePagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse relation types response: %w", err)
+	}
+	return &response, nil
+}
+
+func FindUsers(ctx context.Context, collibraHttpClient *http.Client, name string, limit int) (*UserPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Finding users matching name: '%s'", name))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/users", UsersQueryParams{Name: name, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	var response UserPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse users response: %w", err)
+	}
+	return &response, nil
+}
+
+const (
+	userCacheTTL        = 10 * time.Minute
+	userCacheMaxEntries = 1000
+)
+
+// UserCache keeps the users looked up by name for a while, so user names can be resolved to IDs without repeated
+// calls. Names that match no user are not kept, so users created meanwhile are found on the next lookup.
+type UserCache struct {
+	client *http.Client
+
+	mu    sync.Mutex
+	users map[string]cachedUsers
+}
+
+type cachedUsers struct {
+	users     []UserDetails
+	fetchedAt time.Time
+}
+
+func NewUserCache(collibraHttpClient *http.Client) *UserCache {
+	return &UserCache{
+		client: collibraHttpClient,
+		users:  map[string]cachedUsers{},
+	}
+}
+
+// Users returns the users matching the given name, as reported by the Collibra users API.
+func (c *UserCache) Users(ctx context.Context, name string) ([]UserDetails, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+
+	c.mu.Lock()
+	cached, ok := c.users[key]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < userCacheTTL {
+		return cached.users, nil
+	}
+
+	page, err := FindUsers(ctx, c.client, name, 100)
+	if err != nil {
+		return nil, err
+	}
+	if len(page.Results) == 0 {
+		return page.Results, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.evict()
+	c.users[key] = cachedUsers{users: page.Results, fetchedAt: time.Now()}
+	return page.Results, nil
+}
+
+// evict makes room for a new entry: it drops the expired entries and, when the cache is still full, the oldest one.
+func (c *UserCache) evict() {
+	if len(c.users) < userCacheMaxEntries {
+		return
+	}
+	oldestKey, oldest := "", time.Now()
+	for key, cached := range c.users {
+		if time.Since(cached.fetchedAt) >= userCacheTTL {
+			delete(c.users, key)
+		} else if cached.fetchedAt.Before(oldest) {
+			oldestKey, oldest = key, cached.fetchedAt
+		}
+	}
+	if len(c.users) >= userCacheMaxEntries {
+		delete(c.users, oldestKey)
+	}
+}
@@ -0,0 +1,153 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// ResponsibilityPagedResponse represents the response from the Collibra responsibilities API
+type ResponsibilityPagedResponse struct {
+	Total   int64            `json:"total"`
+	Offset  int64            `json:"offset"`
+	Limit   int64            `json:"limit"`
+	Results []Responsibility `json:"results"`
+}
+
+type Responsibility struct {
+	ID           string                 `json:"id"`
+	Role         NamedResourceReference `json:"role"`
+	Owner        NamedResourceReference `json:"owner"`
+	BaseResource NamedResourceReference `json:"baseResource"`
+}
+
+type ResponsibilitiesQueryParams struct {
+	ResourceIDs      []string `url:"resourceIds,omitempty"`
+	IncludeInherited bool     `url:"includeInherited,omitempty"`
+	Limit            int      `url:"limit,omitempty"`
+	Offset           int      `url:"offset,omitempty"`
+}
+
+type AddResponsibilityRequest struct {
+	RoleID       string `json:"roleId"`
+	ResourceID   string `json:"resourceId"`
+	ResourceType string `json:"resourceType"`
+	OwnerID      string `json:"ownerId"`
+}
+
+// RolePagedResponse represents the response from the Collibra roles API
+type RolePagedResponse struct {
+	Total   int64  `json:"total"`
+	Offset  int64  `json:"offset"`
+	Limit   int64  `json:"limit"`
+	Results []Role `json:"results"`
+}
+
+type Role struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Global      bool   `json:"global"`
+}
+
+// UserGroupPagedResponse represents the response from the Collibra user groups API
+type UserGroupPagedResponse struct {
+	Total   int64              `json:"total"`
+	Offset  int64              `json:"offset"`
+	Limit   int64              `json:"limit"`
+	Results []UserGroupDetails `json:"results"`
+}
+
+type UserGroupDetails struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type NameQueryParams struct {
+	Name  string `url:"name,omitempty"`
+	Limit int    `url:"limit,omitempty"`
+}
+
+func FindResponsibilities(ctx context.Context, collibraHttpClient *http.Client, params ResponsibilitiesQueryParams) (*ResponsibilityPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Finding responsibilities for resources: %v", params.ResourceIDs))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/responsibilities", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ResponsibilityPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse responsibilities response: %w", err)
+	}
+	return &response, nil
+}
+
+func AddResponsibility(ctx context.Context, collibraHttpClient *http.Client, request AddResponsibilityRequest) (*Responsibility, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding responsibility with role '%s' for owner '%s' on resource '%s'", request.RoleID, request.OwnerID, request.ResourceID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/responsibilities", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var responsibility Responsibility
+	if err := json.Unmarshal(body, &responsibility); err != nil {
+		return nil, fmt.Errorf("failed to parse responsibility response: %w", err)
+	}
+	return &responsibility, nil
+}
+
+func FindRoles(ctx context.Context, collibraHttpClient *http.Client, name string) (*RolePagedResponse, error) {
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/roles", NameQueryParams{Name: name, Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	var response RolePagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse roles response: %w", err)
+	}
+	return &response, nil
+}
+
+func FindUserGroups(ctx context.Context, collibraHttpClient *http.Client, name string) (*UserGroupPagedResponse, error) {
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/userGroups", NameQueryParams{Name: name, Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	var response UserGroupPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse user groups response: %w", err)
+	}
+	return &response, nil
+}
+
+func GetUser(ctx context.Context, collibraHttpClient *http.Client, userID string) (*UserDetails, error) {
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/2.0/users/%s", userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user UserDetails
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user response: %w", err)
+	}
+	return &user, nil
+}
+
+func GetUserGroup(ctx context.Context, collibraHttpClient *http.Client, userGroupID string) (*UserGroupDetails, error) {
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/2.0/userGroups/%s", userGroupID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var userGroup UserGroupDetails
+	if err := json.Unmarshal(body, &userGroup); err != nil {
+		return nil, fmt.Errorf("failed to parse user group response: %w", err)
+	}
+	return &userGroup, nil
+}
@@ -7,11 +7,28 @@ import (
 
 // SearchRequest represents the request payload for the Collibra search API
 type SearchRequest struct {
-	Keywords       string         `json:"keywords"`
-	SearchInFields []SearchField  `json:"searchInFields,omitempty"`
-	Filters        []SearchFilter `json:"filters,omitempty"`
-	Limit          int            `json:"limit"`
-	Offset         int            `json:"offset"`
+	Keywords       string                   `json:"keywords"`
+	SearchInFields []SearchField            `json:"searchInFields,omitempty"`
+	Filters        []SearchFilter           `json:"filters,omitempty"`
+	RangeFilters   []SearchRangeFilter      `json:"rangeFilters,omitempty"`
+	Aggregations   []SearchAggregationField `json:"aggregations,omitempty"`
+	SortField      string                   `json:"sortField,omitempty"`
+	SortOrder      string                   `json:"sortOrder,omitempty"`
+	Limit          int                      `json:"limit"`
+	Offset         int                      `json:"offset"`
+}
+
+// SearchRangeFilter restricts a timestamp field to a range, in milliseconds since the epoch
+type SearchRangeFilter struct {
+	Field string `json:"field"`
+	From  int64  `json:"from,omitempty"`
+	To    int64  `json:"to,omitempty"`
+}
+
+// SearchAggregationField requests the bucket counts of the results for a field
+type SearchAggregationField struct {
+	Field string `json:"field"`
+	Limit int    `json:"limit,omitempty"`
 }
 
 type SearchField struct {
@@ -46,6 +63,8 @@ type SearchResource struct {
 }
 
 type SearchHighlight struct {
+	Field  string   `json:"field"`
+	Values []string `json:"values"`
 }
 
 type SearchAggregation struct {
@@ -54,6 +73,9 @@ type SearchAggregation struct {
 }
 
 type SearchAggregationValue struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
 }
 
 func CreateSearchRequest(question string, resourceTypes []string, filters []SearchFilter, limit int, offset int) SearchRequest {
@@ -0,0 +1,57 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+type Tag struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	AssetsCount int    `json:"assetsCount,omitempty"`
+}
+
+type AssetTagsRequest struct {
+	TagNames []string `json:"tagNames"`
+}
+
+func GetAssetTags(ctx context.Context, collibraHttpClient *http.Client, assetID string) ([]Tag, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Getting tags of asset: %s", assetID))
+
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/2.0/assets/%s/tags", assetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []Tag
+	if err := json.Unmarshal(body, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse tags response: %w", err)
+	}
+	return tags, nil
+}
+
+func AddAssetTags(ctx context.Context, collibraHttpClient *http.Client, assetID string, tagNames []string) ([]Tag, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Adding tags %v to asset: %s", tagNames, assetID))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", fmt.Sprintf("/rest/2.0/assets/%s/tags", assetID), AssetTagsRequest{TagNames: tagNames})
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []Tag
+	if err := json.Unmarshal(body, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse tags response: %w", err)
+	}
+	return tags, nil
+}
+
+func RemoveAssetTags(ctx context.Context, collibraHttpClient *http.Client, assetID string, tagNames []string) error {
+	slog.InfoContext(ctx, fmt.Sprintf("Removing tags %v from asset: %s", tagNames, assetID))
+
+	_, err := sendJSON(ctx, collibraHttpClient, "DELETE", fmt.Sprintf("/rest/2.0/assets/%s/tags", assetID), AssetTagsRequest{TagNames: tagNames})
+	return err
+}
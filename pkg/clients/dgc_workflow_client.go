@@ -0,0 +1,183 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// WorkflowDefinitionPagedResponse represents the response from the Collibra workflow definitions API
+type WorkflowDefinitionPagedResponse struct {
+	Total   int64                `json:"total"`
+	Offset  int64                `json:"offset"`
+	Limit   int64                `json:"limit"`
+	Results []WorkflowDefinition `json:"results"`
+}
+
+type WorkflowDefinition struct {
+	ID                       string `json:"id"`
+	Name                     string `json:"name"`
+	Description              string `json:"description,omitempty"`
+	ProcessID                string `json:"processId,omitempty"`
+	Enabled                  bool   `json:"enabled"`
+	BusinessItemResourceType string `json:"businessItemResourceType,omitempty"`
+	StartLabel               string `json:"startLabel,omitempty"`
+}
+
+type WorkflowDefinitionsQueryParams struct {
+	AssetID string `url:"assetId,omitempty"`
+	Name    string `url:"name,omitempty"`
+	Enabled bool   `url:"enabled,omitempty"`
+	Limit   int    `url:"limit,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
+}
+
+// FormData represents the form of a workflow start event or user task
+type FormData struct {
+	FormKey        string         `json:"formKey,omitempty"`
+	FormProperties []FormProperty `json:"formProperties"`
+}
+
+type FormProperty struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Value      string          `json:"value,omitempty"`
+	Required   bool            `json:"required"`
+	Writable   bool            `json:"writable"`
+	Readable   bool            `json:"readable"`
+	EnumValues []FormEnumValue `json:"enumValues,omitempty"`
+}
+
+type FormEnumValue struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type StartWorkflowInstancesRequest struct {
+	WorkflowDefinitionID string            `json:"workflowDefinitionId"`
+	BusinessItemIDs      []string          `json:"businessItemIds,omitempty"`
+	BusinessItemType     string            `json:"businessItemType,omitempty"`
+	FormProperties       map[string]string `json:"formProperties,omitempty"`
+	SendNotification     bool              `json:"sendNotification"`
+}
+
+type WorkflowInstance struct {
+	ID                 string                  `json:"id"`
+	WorkflowDefinition NamedResourceReference  `json:"workflowDefinition"`
+	BusinessItem       *NamedResourceReference `json:"businessItem,omitempty"`
+	StartDate          int64                   `json:"startDate,omitempty"`
+	Ended              bool                    `json:"ended"`
+}
+
+// WorkflowTaskPagedResponse represents the response from the Collibra workflow tasks API
+type WorkflowTaskPagedResponse struct {
+	Total   int64          `json:"total"`
+	Offset  int64          `json:"offset"`
+	Limit   int64          `json:"limit"`
+	Results []WorkflowTask `json:"results"`
+}
+
+type WorkflowTask struct {
+	ID                 string                  `json:"id"`
+	Key                string                  `json:"key,omitempty"`
+	Type               string                  `json:"type,omitempty"`
+	Title              string                  `json:"title,omitempty"`
+	Description        string                  `json:"description,omitempty"`
+	CreateTime         int64                   `json:"createTime,omitempty"`
+	DueDate            int64                   `json:"dueDate,omitempty"`
+	WorkflowInstanceID string                  `json:"workflowInstanceId,omitempty"`
+	WorkflowDefinition *NamedResourceReference `json:"workflowDefinition,omitempty"`
+	BusinessItem       *NamedResourceReference `json:"businessItem,omitempty"`
+}
+
+type WorkflowTasksQueryParams struct {
+	BusinessItemID string `url:"businessItemId,omitempty"`
+	Limit          int    `url:"limit,omitempty"`
+	Offset         int    `url:"offset,omitempty"`
+}
+
+type CompleteWorkflowTasksRequest struct {
+	TaskIDs            []string          `json:"taskIds"`
+	TaskFormProperties map[string]string `json:"taskFormProperties,omitempty"`
+}
+
+func ListWorkflowDefinitions(ctx context.Context, collibraHttpClient *http.Client, params WorkflowDefinitionsQueryParams) (*WorkflowDefinitionPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Listing workflow definitions with limit: %d, offset: %d", params.Limit, params.Offset))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/workflowDefinitions", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WorkflowDefinitionPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow definitions response: %w", err)
+	}
+	return &response, nil
+}
+
+func GetWorkflowStartFormData(ctx context.Context, collibraHttpClient *http.Client, workflowDefinitionID string) (*FormData, error) {
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/2.0/workflowDefinitions/%s/startFormData", workflowDefinitionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var formData FormData
+	if err := json.Unmarshal(body, &formData); err != nil {
+		return nil, fmt.Errorf("failed to parse start form data response: %w", err)
+	}
+	return &formData, nil
+}
+
+func StartWorkflowInstances(ctx context.Context, collibraHttpClient *http.Client, request StartWorkflowInstancesRequest) ([]WorkflowInstance, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Starting workflow definition '%s' for business items: %v", request.WorkflowDefinitionID, request.BusinessItemIDs))
+
+	body, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/workflowInstances", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []WorkflowInstance
+	if err := json.Unmarshal(body, &instances); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow instances response: %w", err)
+	}
+	return instances, nil
+}
+
+func ListWorkflowTasks(ctx context.Context, collibraHttpClient *http.Client, params WorkflowTasksQueryParams) (*WorkflowTaskPagedResponse, error) {
+	slog.InfoContext(ctx, fmt.Sprintf("Listing workflow tasks with limit: %d, offset: %d", params.Limit, params.Offset))
+
+	body, err := getJSON(ctx, collibraHttpClient, "/rest/2.0/workflowTasks", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WorkflowTaskPagedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow tasks response: %w", err)
+	}
+	return &response, nil
+}
+
+func GetWorkflowTaskFormData(ctx context.Context, collibraHttpClient *http.Client, taskID string) (*FormData, error) {
+	body, err := getJSON(ctx, collibraHttpClient, fmt.Sprintf("/rest/2.0/workflowTasks/%s/taskFormData", taskID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var formData FormData
+	if err := json.Unmarshal(body, &formData); err != nil {
+		return nil, fmt.Errorf("failed to parse task form data response: %w", err)
+	}
+	return &formData, nil
+}
+
+func CompleteWorkflowTasks(ctx context.Context, collibraHttpClient *http.Client, request CompleteWorkflowTasksRequest) error {
+	slog.InfoContext(ctx, fmt.Sprintf("Completing workflow tasks: %v", request.TaskIDs))
+
+	_, err := sendJSON(ctx, collibraHttpClient, "POST", "/rest/2.0/workflowTasks/completed", request)
+	return err
+}
@@ -0,0 +1,228 @@
+package datacontract
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Contract is the part of an ODCS v2 or v3 manifest that matters to the consumers of a data contract, in a form
+// that does not depend on the version of the standard.
+type Contract struct {
+	Standard string
+	ID       string
+	Version  string
+	Status   string
+	// Columns are keyed by table and column name, e.g. orders.amount. Nested v3 properties are joined with dots.
+	Columns map[string]Column
+	// SLAs are keyed by property, followed by the element it applies to if any, e.g. latency@orders.amount.
+	SLAs map[string]string
+	// QualityRules are keyed by the element they apply to, if any, and the name of the rule, e.g. orders.amount:nullCount.
+	QualityRules map[string]string
+	// Owners maps the usernames of the team, or the stakeholders in v2, to their role.
+	Owners map[string]string
+}
+
+type Column struct {
+	LogicalType  string
+	PhysicalType string
+	Required     bool
+}
+
+// Type describes the type of the column, e.g. integer (bigint).
+func (c Column) Type() string {
+	switch {
+	case c.PhysicalType == "":
+		return c.LogicalType
+	case c.LogicalType == "":
+		return c.PhysicalType
+	}
+	return fmt.Sprintf("%s (%s)", c.LogicalType, c.PhysicalType)
+}
+
+type manifestV3 struct {
+	ID            string           `yaml:"id"`
+	Version       string           `yaml:"version"`
+	Status        string           `yaml:"status"`
+	Schema        []schemaObjectV3 `yaml:"schema"`
+	Quality       []map[string]any `yaml:"quality"`
+	Team          []teamMember     `yaml:"team"`
+	SLAProperties []slaProperty    `yaml:"slaProperties"`
+}
+
+type schemaObjectV3 struct {
+	Name       string             `yaml:"name"`
+	Properties []schemaPropertyV3 `yaml:"properties"`
+	Quality    []map[string]any   `yaml:"quality"`
+}
+
+type schemaPropertyV3 struct {
+	Name         string             `yaml:"name"`
+	LogicalType  string             `yaml:"logicalType"`
+	PhysicalType string             `yaml:"physicalType"`
+	Required     bool               `yaml:"required"`
+	Properties   []schemaPropertyV3 `yaml:"properties"`
+	Quality      []map[string]any   `yaml:"quality"`
+}
+
+type manifestV2 struct {
+	UUID          string           `yaml:"uuid"`
+	Version       string           `yaml:"version"`
+	Status        string           `yaml:"status"`
+	Dataset       []datasetV2      `yaml:"dataset"`
+	Quality       []map[string]any `yaml:"quality"`
+	Stakeholders  []teamMember     `yaml:"stakeholders"`
+	SLAProperties []slaProperty    `yaml:"slaProperties"`
+}
+
+type datasetV2 struct {
+	Table   string           `yaml:"table"`
+	Columns []columnV2       `yaml:"columns"`
+	Quality []map[string]any `yaml:"quality"`
+}
+
+type columnV2 struct {
+	Column       string           `yaml:"column"`
+	LogicalType  string           `yaml:"logicalType"`
+	PhysicalType string           `yaml:"physicalType"`
+	IsNullable   *bool            `yaml:"isNullable"`
+	Quality      []map[string]any `yaml:"quality"`
+}
+
+type teamMember struct {
+	Username string `yaml:"username"`
+	Role     string `yaml:"role"`
+}
+
+type slaProperty struct {
+	Property string `yaml:"property"`
+	Value    any    `yaml:"value"`
+	ValueExt any    `yaml:"valueExt"`
+	Unit     string `yaml:"unit"`
+	Element  string `yaml:"element"`
+	Column   string `yaml:"column"`
+}
+
+// Parse reads an ODCS v2 or v3 manifest. It does not validate the manifest, see Validate.
+func Parse(manifest []byte) (*Contract, error) {
+	root, err := parseManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion := scalarValue(mappingValue(root, "apiVersion"))
+	contract := &Contract{
+		Columns:      map[string]Column{},
+		SLAs:         map[string]string{},
+		QualityRules: map[string]string{},
+		Owners:       map[string]string{},
+	}
+	switch {
+	case strings.HasPrefix(apiVersion, "v3."):
+		var m manifestV3
+		if err := root.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to read the %s manifest: %w", StandardODCSv3, err)
+		}
+		contract.Standard, contract.ID, contract.Version, contract.Status = StandardODCSv3, m.ID, m.Version, m.Status
+		contract.addQualityRules("", m.Quality)
+		for _, object := range m.Schema {
+			contract.addQualityRules(object.Name, object.Quality)
+			contract.addPropertiesV3(object.Name, object.Properties)
+		}
+		contract.addOwners(m.Team)
+		contract.addSLAs(m.SLAProperties)
+	case strings.HasPrefix(apiVersion, "v2."):
+		var m manifestV2
+		if err := root.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to read the %s manifest: %w", StandardODCSv2, err)
+		}
+		contract.Standard, contract.ID, contract.Version, contract.Status = StandardODCSv2, m.UUID, m.Version, m.Status
+		contract.addQualityRules("", m.Quality)
+		for _, table := range m.Dataset {
+			contract.addQualityRules(table.Table, table.Quality)
+			for _, column := range table.Columns {
+				element := table.Table + "." + column.Column
+				contract.Columns[element] = Column{
+					LogicalType:  column.LogicalType,
+					PhysicalType: column.PhysicalType,
+					Required:     column.IsNullable != nil && !*column.IsNullable,
+				}
+				contract.addQualityRules(element, column.Quality)
+			}
+		}
+		contract.addOwners(m.Stakeholders)
+		contract.addSLAs(m.SLAProperties)
+	default:
+		return nil, fmt.Errorf("apiVersion '%s' is not an ODCS v2 or v3 version", apiVersion)
+	}
+	return contract, nil
+}
+
+func (c *Contract) addPropertiesV3(parent string, properties []schemaPropertyV3) {
+	for _, property := range properties {
+		element := parent + "." + property.Name
+		c.Columns[element] = Column{
+			LogicalType:  property.LogicalType,
+			PhysicalType: property.PhysicalType,
+			Required:     property.Required,
+		}
+		c.addQualityRules(element, property.Quality)
+		c.addPropertiesV3(element, property.Properties)
+	}
+}
+
+// addQualityRules keys rules by their name, or failing that by what identifies them in either version of the
+// standard, and describes each by its full definition so that any change to it is noticed. Rules without anything
+// that identifies them are keyed by their type and a hash of their definition, so that reordering them is not a
+// change.
+func (c *Contract) addQualityRules(element string, rules []map[string]any) {
+	for _, rule := range rules {
+		definition, err := json.Marshal(rule)
+		if err != nil {
+			definition = []byte(fmt.Sprint(rule))
+		}
+		name := ""
+		for _, field := range []string{"name", "rule", "code", "templateName", "query"} {
+			if value, ok := rule[field].(string); ok && value != "" {
+				name = value
+				break
+			}
+		}
+		if name == "" {
+			ruleType, _ := rule["type"].(string)
+			hash := sha256.Sum256(definition)
+			name = fmt.Sprintf("%s#%x", cmp.Or(ruleType, "rule"), hash[:4])
+		}
+		if element != "" {
+			name = element + ":" + name
+		}
+		c.QualityRules[name] = string(definition)
+	}
+}
+
+func (c *Contract) addOwners(members []teamMember) {
+	for _, member := range members {
+		if member.Username != "" {
+			c.Owners[member.Username] = member.Role
+		}
+	}
+}
+
+func (c *Contract) addSLAs(properties []slaProperty) {
+	for _, property := range properties {
+		key := property.Property
+		if element := cmp.Or(property.Element, property.Column); element != "" {
+			key += "@" + element
+		}
+		value := fmt.Sprint(property.Value)
+		if property.ValueExt != nil {
+			value += " - " + fmt.Sprint(property.ValueExt)
+		}
+		if property.Unit != "" {
+			value += " " + property.Unit
+		}
+		c.SLAs[key] = value
+	}
+}
@@ -0,0 +1,120 @@
+package datacontract
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const (
+	CategorySchema    = "schema"
+	CategorySLA       = "sla"
+	CategoryQuality   = "quality"
+	CategoryOwnership = "ownership"
+)
+
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeRetyped = "retyped"
+	ChangeChanged = "changed"
+)
+
+// Change is a difference between two versions of a data contract. A change is breaking when consumers relying on
+// the previous version could be affected: a column that is removed, retyped or no longer required, and an SLA or
+// quality rule that is removed or changed. Additions and ownership changes never break consumers.
+type Change struct {
+	Category string `json:"category"`
+	Kind     string `json:"kind"`
+	Element  string `json:"element"`
+	Before   string `json:"before,omitempty"`
+	After    string `json:"after,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("%s %s added: %s", c.Category, c.Element, c.After)
+	case ChangeRemoved:
+		return fmt.Sprintf("%s %s removed: %s", c.Category, c.Element, c.Before)
+	}
+	return fmt.Sprintf("%s %s %s: %s -> %s", c.Category, c.Element, c.Kind, c.Before, c.After)
+}
+
+// Diff lists the changes from the previous to the next version of a data contract, by category and element.
+func Diff(previous *Contract, next *Contract) []Change {
+	changes := []Change{}
+	changes = append(changes, diffColumns(previous.Columns, next.Columns)...)
+	changes = append(changes, diffValues(CategorySLA, previous.SLAs, next.SLAs, true)...)
+	changes = append(changes, diffValues(CategoryQuality, previous.QualityRules, next.QualityRules, true)...)
+	changes = append(changes, diffValues(CategoryOwnership, previous.Owners, next.Owners, false)...)
+	return changes
+}
+
+// HasBreakingChanges tells whether any of the changes is breaking.
+func HasBreakingChanges(changes []Change) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool { return c.Breaking })
+}
+
+func diffColumns(previous map[string]Column, next map[string]Column) []Change {
+	var changes []Change
+	for _, element := range sortedKeys(previous, next) {
+		before, wasThere := previous[element]
+		after, isThere := next[element]
+		switch {
+		case !isThere:
+			changes = append(changes, Change{Category: CategorySchema, Kind: ChangeRemoved, Element: element, Before: before.Type(), Breaking: true})
+		case !wasThere:
+			changes = append(changes, Change{Category: CategorySchema, Kind: ChangeAdded, Element: element, After: after.Type()})
+		case before.LogicalType != after.LogicalType || before.PhysicalType != after.PhysicalType:
+			changes = append(changes, Change{Category: CategorySchema, Kind: ChangeRetyped, Element: element, Before: before.Type(), After: after.Type(), Breaking: true})
+		case before.Required != after.Required:
+			changes = append(changes, Change{
+				Category: CategorySchema,
+				Kind:     ChangeChanged,
+				Element:  element,
+				Before:   requiredness(before.Required),
+				After:    requiredness(after.Required),
+				Breaking: before.Required,
+			})
+		}
+	}
+	return changes
+}
+
+func diffValues(category string, previous map[string]string, next map[string]string, breaking bool) []Change {
+	var changes []Change
+	for _, element := range sortedKeys(previous, next) {
+		before, wasThere := previous[element]
+		after, isThere := next[element]
+		switch {
+		case !isThere:
+			changes = append(changes, Change{Category: category, Kind: ChangeRemoved, Element: element, Before: before, Breaking: breaking})
+		case !wasThere:
+			changes = append(changes, Change{Category: category, Kind: ChangeAdded, Element: element, After: after})
+		case before != after:
+			changes = append(changes, Change{Category: category, Kind: ChangeChanged, Element: element, Before: before, After: after, Breaking: breaking})
+		}
+	}
+	return changes
+}
+
+func requiredness(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
+
+func sortedKeys[V any](previous map[string]V, next map[string]V) []string {
+	keys := slices.Collect(maps.Keys(previous))
+	for key := range next {
+		if _, ok := previous[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, cmp.Compare[string])
+	return keys
+}
@@ -0,0 +1,122 @@
+package datacontract_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/datacontract"
+)
+
+func parseManifest(t *testing.T, manifest string) *datacontract.Contract {
+	t.Helper()
+	contract, err := datacontract.Parse([]byte(manifest))
+	if err != nil {
+		t.Fatalf("Failed to parse manifest: %v", err)
+	}
+	return contract
+}
+
+func TestParse(t *testing.T) {
+	v3 := parseManifest(t, readManifest(t, "odcs-v3.yaml"))
+	if v3.ID != "orders-contract" || v3.Version != "1.0.0" || len(v3.Columns) != 3 || len(v3.QualityRules) != 2 || len(v3.SLAs) != 2 || v3.Owners["ann"] != "owner" {
+		t.Errorf("Unexpected v3 contract: %+v", v3)
+	}
+	if column := v3.Columns["orders.order_id"]; column.Type() != "integer (bigint)" || !column.Required {
+		t.Errorf("Unexpected column: %+v", column)
+	}
+
+	v2 := parseManifest(t, readManifest(t, "odcs-v2.yaml"))
+	if v2.ID != "53581432-6c55-4ba2-a65f-72344a91553a" || len(v2.Columns) != 2 || v2.QualityRules["orders.amount:NullCheck"] == "" || v2.SLAs["latency"] != "4 h" {
+		t.Errorf("Unexpected v2 contract: %+v", v2)
+	}
+
+	if _, err := datacontract.Parse([]byte("apiVersion: 1.0.3\n")); err == nil {
+		t.Errorf("Expected a manifest that is not ODCS to be rejected")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	previous := readManifest(t, "odcs-v3.yaml")
+	next := strings.NewReplacer(
+		"version: 1.0.0", "version: 2.0.0",
+		"      - name: customer_email\n        logicalType: string\n        physicalType: varchar(255)\n        classification: confidential\n", "",
+		"physicalType: decimal(10,2)", "physicalType: decimal(12,2)\n        required: true",
+		"            mustBe: 0", "            mustBe: 1",
+		"  - username: bob\n    role: data steward\n", "  - username: carl\n    role: data steward\n",
+		"    value: 4\n", "    value: 8\n",
+	).Replace(previous) + "  - property: frequency\n    value: 1\n    unit: d\n"
+
+	changes := datacontract.Diff(parseManifest(t, previous), parseManifest(t, next))
+	var described []string
+	for _, change := range changes {
+		breaking := ""
+		if change.Breaking {
+			breaking = " (breaking)"
+		}
+		described = append(described, change.String()+breaking)
+	}
+	expected := []string{
+		"schema orders.amount retyped: number (decimal(10,2)) -> number (decimal(12,2)) (breaking)",
+		"schema orders.customer_email removed: string (varchar(255)) (breaking)",
+		"sla frequency added: 1 d",
+		"sla latency changed: 4 h -> 8 h (breaking)",
+		`quality orders.amount:nullCount changed: {"mustBe":0,"rule":"nullCount","type":"library"} -> {"mustBe":1,"rule":"nullCount","type":"library"} (breaking)`,
+		"ownership bob removed: data steward",
+		"ownership carl added: data steward",
+	}
+	if strings.Join(described, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected changes:\n%s", strings.Join(described, "\n"))
+	}
+	if !datacontract.HasBreakingChanges(changes) {
+		t.Errorf("Expected breaking changes")
+	}
+}
+
+func TestDiff_NonBreakingAcrossVersions(t *testing.T) {
+	previous := parseManifest(t, readManifest(t, "odcs-v2.yaml"))
+	next := parseManifest(t, readManifest(t, "odcs-v3.yaml"))
+	delete(next.SLAs, "retention")
+	next.QualityRules = previous.QualityRules
+	next.Owners = previous.Owners
+	next.Columns["orders.order_id"] = datacontract.Column{LogicalType: "integer", PhysicalType: "bigint"}
+
+	changes := datacontract.Diff(previous, next)
+	if datacontract.HasBreakingChanges(changes) {
+		t.Errorf("Expected no breaking changes, got: %+v", changes)
+	}
+	if len(changes) != 1 || changes[0].Element != "orders.customer_email" || changes[0].Kind != datacontract.ChangeAdded {
+		t.Errorf("Expected only the added column, got: %+v", changes)
+	}
+}
+
+func TestDiff_ReorderedUnnamedQualityRules(t *testing.T) {
+	manifest := "apiVersion: v3.0.2\nkind: DataContract\nid: orders-contract\nversion: 1.0.0\nstatus: active\nschema:\n  - name: orders\n    quality:\n%s"
+	first := "      - type: text\n        description: Orders are never deleted\n"
+	second := "      - type: library\n        mustBeGreaterThan: 0\n        unit: rows\n"
+	previous := parseManifest(t, fmt.Sprintf(manifest, first+second))
+	next := parseManifest(t, fmt.Sprintf(manifest, second+first))
+
+	if changes := datacontract.Diff(previous, next); len(changes) != 0 {
+		t.Errorf("Expected reordered quality rules not to be a change, got: %+v", changes)
+	}
+
+	changed := parseManifest(t, fmt.Sprintf(manifest, first+strings.Replace(second, "0", "10", 1)))
+	changes := datacontract.Diff(previous, changed)
+	if len(changes) != 2 || !datacontract.HasBreakingChanges(changes) {
+		t.Errorf("Expected the changed rule to be reported as removed and added, got: %+v", changes)
+	}
+}
+
+func TestDiff_RequiredColumn(t *testing.T) {
+	previous := &datacontract.Contract{Columns: map[string]datacontract.Column{"orders.id": {LogicalType: "integer", Required: true}}}
+	next := &datacontract.Contract{Columns: map[string]datacontract.Column{"orders.id": {LogicalType: "integer"}}}
+
+	changes := datacontract.Diff(previous, next)
+	if len(changes) != 1 || !changes[0].Breaking || changes[0].After != "optional" {
+		t.Errorf("Expected a column that is no longer required to be a breaking change, got: %+v", changes)
+	}
+	if changes = datacontract.Diff(next, previous); len(changes) != 1 || changes[0].Breaking {
+		t.Errorf("Expected a column that becomes required not to be a breaking change, got: %+v", changes)
+	}
+}
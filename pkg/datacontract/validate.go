@@ -0,0 +1,593 @@
+// Package datacontract validates and compares data contract manifests that follow the Open Data Contract Standard
+// (ODCS), without calling Collibra.
+package datacontract
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+const (
+	StandardODCSv2 = "ODCS v2"
+	StandardODCSv3 = "ODCS v3"
+)
+
+// The published ODCS JSON Schemas, from https://github.com/bitol-io/open-data-contract-standard/tree/main/schema.
+//
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// The fields whose absence is reported as a warning, by path in the manifest, where [] stands for any item of a list.
+var (
+	recommendedV2 = map[string][]string{
+		"":                    {"description", "dataset", "stakeholders"},
+		"description":         {"purpose"},
+		"dataset[]":           {"columns"},
+		"dataset[].columns[]": {"logicalType"},
+		"stakeholders[]":      {"username", "role"},
+	}
+	recommendedV3 = map[string][]string{
+		"":                      {"name", "description", "schema", "team"},
+		"description":           {"purpose"},
+		"schema[]":              {"properties"},
+		"schema[].properties[]": {"logicalType"},
+		"team[]":                {"username", "role"},
+	}
+)
+
+var (
+	odcsV2 = mustLoadStandard(StandardODCSv2, "v2.", "schemas/odcs-json-schema-v2.2.2.json", recommendedV2)
+	odcsV3 = mustLoadStandard(StandardODCSv3, "v3.", "schemas/odcs-json-schema-v3.0.2.json", recommendedV3)
+)
+
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): `)
+
+// Finding is an error or warning found in a manifest. Line and Column are 1-based, and 0 when unknown.
+type Finding struct {
+	Severity string `json:"severity"`
+	Path     string `json:"path,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (f Finding) String() string {
+	location := f.Path
+	if f.Line > 0 {
+		location = fmt.Sprintf("line %d", f.Line)
+		if f.Path != "" {
+			location += " (" + f.Path + ")"
+		}
+	}
+	if location == "" {
+		return f.Message
+	}
+	return location + ": " + f.Message
+}
+
+// Report is the outcome of the validation of a manifest. Standard is empty when the manifest does not declare an
+// apiVersion of the embedded ODCS JSON Schemas, in which case only its YAML syntax is checked.
+type Report struct {
+	APIVersion string    `json:"apiVersion,omitempty"`
+	Standard   string    `json:"standard,omitempty"`
+	Findings   []Finding `json:"findings"`
+
+	parsed bool
+}
+
+// Parsed tells whether the manifest is valid YAML. When it is not, the syntax error is the only finding.
+func (r Report) Parsed() bool {
+	return r.parsed
+}
+
+// Valid tells whether the manifest has no errors. Warnings do not make a manifest invalid.
+func (r Report) Valid() bool {
+	return len(r.Errors()) == 0
+}
+
+func (r Report) Errors() []Finding {
+	return r.withSeverity(SeverityError)
+}
+
+func (r Report) Warnings() []Finding {
+	return r.withSeverity(SeverityWarning)
+}
+
+func (r Report) withSeverity(severity string) []Finding {
+	findings := []Finding{}
+	for _, finding := range r.Findings {
+		if finding.Severity == severity {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// Validate parses a manifest as YAML and validates it against the published JSON Schema of the ODCS version it
+// declares. Each finding points at the line of the YAML node it is about.
+func Validate(manifest []byte) Report {
+	report := Report{Findings: []Finding{}}
+	root, err := parseManifest(manifest)
+	if err != nil {
+		report.Findings = append(report.Findings, syntaxFinding(err))
+		return report
+	}
+
+	report.parsed = true
+	report.APIVersion = scalarValue(mappingValue(root, "apiVersion"))
+	var standard *standard
+	switch {
+	case odcsV3.supports(report.APIVersion):
+		standard = odcsV3
+	case odcsV2.supports(report.APIVersion):
+		standard = odcsV2
+	default:
+		node := mappingValue(root, "apiVersion")
+		if node == nil {
+			node = root
+		}
+		report.Findings = append(report.Findings, Finding{
+			Severity: SeverityWarning,
+			Path:     "apiVersion",
+			Line:     node.Line,
+			Column:   node.Column,
+			Message: fmt.Sprintf("apiVersion '%s' is not one of the ODCS versions %s, only the YAML syntax was checked",
+				report.APIVersion, strings.Join(slices.Concat(odcsV3.apiVersions(), odcsV2.apiVersions()), ", ")),
+		})
+		return report
+	}
+
+	report.Standard = standard.name
+	v := &validation{standard: standard}
+	v.validate(root, standard.schema, "")
+	v.recommend(root, "", "")
+	report.Findings = compactFindings(v.findings)
+	return report
+}
+
+// parseManifest parses a manifest into the YAML node of its top-level mapping.
+func parseManifest(manifest []byte) (*yaml.Node, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(manifest, &document); err != nil {
+		return nil, err
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return nil, fmt.Errorf("the manifest is empty")
+	}
+	root := resolveAlias(document.Content[0])
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("yaml: line %d: the manifest must be a mapping of fields, got %s", root.Line, nodeType(root))
+	}
+	return root, nil
+}
+
+func syntaxFinding(err error) Finding {
+	finding := Finding{Severity: SeverityError, Message: err.Error()}
+	if match := yamlErrorLine.FindStringSubmatch(finding.Message); match != nil {
+		finding.Line, _ = strconv.Atoi(match[1])
+		finding.Message = strings.TrimPrefix(finding.Message, match[0])
+	}
+	return finding
+}
+
+// compactFindings sorts findings by line and drops the ones reported twice, as a node can be validated against
+// several schemas that share a rule.
+func compactFindings(findings []Finding) []Finding {
+	slices.SortStableFunc(findings, func(a, b Finding) int { return a.Line - b.Line })
+	compacted := []Finding{}
+	seen := map[Finding]bool{}
+	for _, finding := range findings {
+		if !seen[finding] {
+			seen[finding] = true
+			compacted = append(compacted, finding)
+		}
+	}
+	return compacted
+}
+
+// standard is a major version of ODCS with its JSON Schema, which is used for the apiVersions that start with prefix
+// and that the schema lists.
+//
+// jsonschema-go stops at the first error and does not tell where in the instance it is, so each YAML node is
+// validated on its own against a shallow copy of its schema, in which the schemas of its properties and items accept
+// anything, and the walk goes on with the children. The resolved shallow copies are cached by the schema they were
+// made from.
+type standard struct {
+	name        string
+	prefix      string
+	schema      *jsonschema.Schema
+	recommended map[string][]string
+
+	mu       sync.Mutex
+	shallows map[*jsonschema.Schema]*jsonschema.Resolved
+	deeps    map[*jsonschema.Schema]*jsonschema.Resolved
+}
+
+func (s *standard) apiVersions() []string {
+	var versions []string
+	for _, version := range s.schema.Properties["apiVersion"].Enum {
+		if version, ok := version.(string); ok && strings.HasPrefix(version, s.prefix) {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+func (s *standard) supports(apiVersion string) bool {
+	return slices.Contains(s.apiVersions(), apiVersion)
+}
+
+// definition returns the schema a $ref points to, or nil when it does not point into $defs.
+func (s *standard) definition(ref string) *jsonschema.Schema {
+	name, ok := strings.CutPrefix(ref, "#/$defs/")
+	if !ok {
+		return nil
+	}
+	return s.schema.Defs[name]
+}
+
+// resolved returns the resolved shallow copy of a schema, or the resolved schema itself when deep is true.
+func (s *standard) resolved(schema *jsonschema.Schema, deep bool) (*jsonschema.Resolved, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cache := s.shallows
+	if deep {
+		cache = s.deeps
+	}
+	if resolved, ok := cache[schema]; ok {
+		return resolved, nil
+	}
+	root := schema.CloneSchemas()
+	if !deep {
+		root = s.shallow(schema)
+	}
+	root.Schema = ""
+	// Resolving $defs takes most of the time, so they are only added for the conditions that refer to them.
+	if data, err := json.Marshal(root); err != nil || bytes.Contains(data, []byte(`"$ref"`)) {
+		root.Defs = map[string]*jsonschema.Schema{}
+		for name, definition := range s.schema.Defs {
+			root.Defs[name] = definition.CloneSchemas()
+		}
+	}
+	resolved, err := root.Resolve(nil)
+	if err != nil {
+		return nil, err
+	}
+	cache[schema] = resolved
+	return resolved, nil
+}
+
+// shallow copies a schema without what applies to the values of its properties and items, and without the
+// additionalProperties and unevaluatedProperties that the walk checks itself. The schemas it refers to or applies in
+// place are copied the same way, but not the ones it only uses as conditions.
+func (s *standard) shallow(schema *jsonschema.Schema) *jsonschema.Schema {
+	copied := schema.CloneSchemas()
+	for name := range copied.Properties {
+		copied.Properties[name] = &jsonschema.Schema{}
+	}
+	for pattern := range copied.PatternProperties {
+		copied.PatternProperties[pattern] = &jsonschema.Schema{}
+	}
+	if copied.Items != nil {
+		copied.Items = &jsonschema.Schema{}
+	}
+	copied.AdditionalProperties = nil
+	copied.UnevaluatedProperties = nil
+	copied.Defs = nil
+	for i, subschema := range schema.AllOf {
+		copied.AllOf[i] = s.shallow(subschema)
+	}
+	if definition := s.definition(schema.Ref); definition != nil {
+		copied.Ref = ""
+		copied.AllOf = append(copied.AllOf, s.shallow(definition))
+	}
+	if schema.Then != nil {
+		copied.Then = s.shallow(schema.Then)
+	}
+	if schema.Else != nil {
+		copied.Else = s.shallow(schema.Else)
+	}
+	return copied
+}
+
+type validation struct {
+	standard *standard
+	findings []Finding
+}
+
+func (v *validation) report(severity string, node *yaml.Node, path string, format string, args ...any) {
+	v.findings = append(v.findings, Finding{
+		Severity: severity,
+		Path:     path,
+		Line:     node.Line,
+		Column:   node.Column,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validation) validate(node *yaml.Node, schema *jsonschema.Schema, path string) {
+	node = resolveAlias(node)
+	value := jsonValue(node)
+	resolved, err := v.standard.resolved(schema, false)
+	if err != nil {
+		v.report(SeverityError, node, path, "failed to load the %s JSON Schema: %v", v.standard.name, err)
+		return
+	}
+	if err := resolved.Validate(value); err != nil {
+		v.report(SeverityError, node, path, "%s", innermost(err).Error())
+	}
+
+	schemas := v.applicable(schema, value)
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, child := node.Content[i], node.Content[i+1]
+			v.validateProperty(key, child, value, schemas, joinPath(path, key.Value))
+		}
+	case yaml.SequenceNode:
+		for _, s := range schemas {
+			if s.Items == nil {
+				continue
+			}
+			for i, item := range node.Content {
+				v.validate(item, s.Items, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+}
+
+func (v *validation) validateProperty(key, value *yaml.Node, object any, schemas []*jsonschema.Schema, path string) {
+	evaluated := false
+	for _, s := range schemas {
+		if property, ok := s.Properties[key.Value]; ok {
+			evaluated = true
+			v.validate(value, property, path)
+		} else if s.AdditionalProperties != nil {
+			v.validateUnknownProperty(key, value, s.AdditionalProperties, path)
+		}
+	}
+	for _, s := range schemas {
+		if s.UnevaluatedProperties == nil || evaluated {
+			continue
+		}
+		// Only the schemas that apply in place of s count, not the ones s is applied in place of.
+		if !slices.ContainsFunc(v.applicable(s, object), func(applied *jsonschema.Schema) bool {
+			_, ok := applied.Properties[key.Value]
+			return ok || applied.AdditionalProperties != nil
+		}) {
+			v.validateUnknownProperty(key, value, s.UnevaluatedProperties, path)
+		}
+	}
+}
+
+func (v *validation) validateUnknownProperty(key, value *yaml.Node, schema *jsonschema.Schema, path string) {
+	if isFalse(schema) {
+		v.report(SeverityError, key, path, "property '%s' is not allowed", key.Value)
+		return
+	}
+	v.validate(value, schema, path)
+}
+
+// applicable returns a schema with the ones it applies in place to a value: the one it refers to, the ones of allOf,
+// and then or else depending on whether the value passes its if.
+func (v *validation) applicable(schema *jsonschema.Schema, value any) []*jsonschema.Schema {
+	schemas := []*jsonschema.Schema{schema}
+	if definition := v.standard.definition(schema.Ref); definition != nil {
+		schemas = append(schemas, v.applicable(definition, value)...)
+	}
+	for _, subschema := range schema.AllOf {
+		schemas = append(schemas, v.applicable(subschema, value)...)
+	}
+	if schema.If != nil {
+		branch := schema.Else
+		if condition, err := v.standard.resolved(schema.If, true); err == nil && condition.Validate(value) == nil {
+			branch = schema.Then
+		}
+		if branch != nil {
+			schemas = append(schemas, v.applicable(branch, value)...)
+		}
+	}
+	return schemas
+}
+
+// recommend reports the recommended fields that are missing, walking the manifest with the path pattern of each node.
+func (v *validation) recommend(node *yaml.Node, path string, pattern string) {
+	node = resolveAlias(node)
+	switch node.Kind {
+	case yaml.MappingNode:
+		present := map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			present[key] = true
+			v.recommend(node.Content[i+1], joinPath(path, key), joinPath(pattern, key))
+		}
+		for _, field := range v.standard.recommended[pattern] {
+			if !present[field] {
+				v.report(SeverityWarning, node, path, "missing recommended field '%s'", field)
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			v.recommend(item, fmt.Sprintf("%s[%d]", path, i), pattern+"[]")
+		}
+	}
+}
+
+// innermost returns the error jsonschema-go wrapped in the paths of the schemas it was found in.
+func innermost(err error) error {
+	for wrapped := errors.Unwrap(err); wrapped != nil; wrapped = errors.Unwrap(err) {
+		err = wrapped
+	}
+	return err
+}
+
+// isFalse tells whether a schema is the false schema, which jsonschema-go reads as {"not": {}}.
+func isFalse(schema *jsonschema.Schema) bool {
+	return schema.Not != nil && reflect.ValueOf(*schema.Not).IsZero()
+}
+
+// jsonValue converts a YAML node to the JSON value jsonschema-go validates.
+func jsonValue(node *yaml.Node) any {
+	node = resolveAlias(node)
+	switch node.Kind {
+	case yaml.MappingNode:
+		object := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			object[node.Content[i].Value] = jsonValue(node.Content[i+1])
+		}
+		return object
+	case yaml.SequenceNode:
+		array := make([]any, len(node.Content))
+		for i, item := range node.Content {
+			array[i] = jsonValue(item)
+		}
+		return array
+	}
+	switch node.ShortTag() {
+	case "!!int", "!!float":
+		var number float64
+		if err := node.Decode(&number); err == nil {
+			return number
+		}
+	case "!!bool":
+		var boolean bool
+		if err := node.Decode(&boolean); err == nil {
+			return boolean
+		}
+	case "!!null":
+		return nil
+	}
+	return node.Value
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+// nodeType returns the JSON type of a YAML node.
+func nodeType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	switch node.ShortTag() {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	}
+	return "string"
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return resolveAlias(node.Content[i+1])
+		}
+	}
+	return nil
+}
+
+func scalarValue(node *yaml.Node) string {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return node.Value
+}
+
+func mustLoadStandard(name string, prefix string, file string, recommended map[string][]string) *standard {
+	data, err := schemaFiles.ReadFile(file)
+	if err != nil {
+		panic(fmt.Sprintf("failed to read embedded schema %s: %v", file, err))
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		panic(fmt.Sprintf("failed to parse embedded schema %s: %v", file, err))
+	}
+	flattenDefinitions(raw)
+	if data, err = json.Marshal(raw); err != nil {
+		panic(fmt.Sprintf("failed to flatten embedded schema %s: %v", file, err))
+	}
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		panic(fmt.Sprintf("failed to parse embedded schema %s: %v", file, err))
+	}
+	return &standard{
+		name:        name,
+		prefix:      prefix,
+		schema:      &schema,
+		recommended: recommended,
+		shallows:    map[*jsonschema.Schema]*jsonschema.Resolved{},
+		deeps:       map[*jsonschema.Schema]*jsonschema.Resolved{},
+	}
+}
+
+// flattenDefinitions moves the schemas that are nested in a group of $defs, like the servers of ODCS v3 under
+// $defs/ServerSource, to $defs itself, as jsonschema-go cannot follow a $ref into something that is not a schema.
+func flattenDefinitions(schema map[string]any) {
+	definitions, _ := schema["$defs"].(map[string]any)
+	groups := map[string]bool{}
+	var flatten func(value any)
+	flatten = func(value any) {
+		switch value := value.(type) {
+		case map[string]any:
+			ref, _ := value["$ref"].(string)
+			if name, ok := strings.CutPrefix(ref, "#/$defs/"); ok {
+				if group, member, nested := strings.Cut(name, "/"); nested {
+					if members, ok := definitions[group].(map[string]any); ok {
+						definitions[group+"."+member] = members[member]
+					}
+					groups[group] = true
+					value["$ref"] = "#/$defs/" + group + "." + member
+				}
+			}
+			for _, v := range value {
+				flatten(v)
+			}
+		case []any:
+			for _, v := range value {
+				flatten(v)
+			}
+		}
+	}
+	flatten(schema)
+	for group := range groups {
+		delete(definitions, group)
+	}
+}
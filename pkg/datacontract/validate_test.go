@@ -0,0 +1,123 @@
+package datacontract_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/datacontract"
+)
+
+func readManifest(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", name, err)
+	}
+	return string(data)
+}
+
+func TestValidate_ValidManifests(t *testing.T) {
+	for name, standard := range map[string]string{
+		"odcs-v3.yaml": datacontract.StandardODCSv3,
+		"odcs-v2.yaml": datacontract.StandardODCSv2,
+	} {
+		t.Run(name, func(t *testing.T) {
+			report := datacontract.Validate([]byte(readManifest(t, name)))
+			if report.Standard != standard || !report.Valid() {
+				t.Fatalf("Expected a valid %s manifest, got: %+v", standard, report)
+			}
+			if len(report.Findings) != 0 {
+				t.Errorf("Expected no warnings, got: %+v", report.Findings)
+			}
+		})
+	}
+}
+
+func TestValidate_Errors(t *testing.T) {
+	manifest := strings.NewReplacer(
+		"kind: DataContract\n", "",
+		"version: 1.0.0", "version: 1",
+		"logicalType: number", "logicalType: decimal",
+		"  - property: retention\n", "  - unit: d\n",
+	).Replace(readManifest(t, "odcs-v3.yaml"))
+
+	report := datacontract.Validate([]byte(manifest))
+	if report.Valid() {
+		t.Fatalf("Expected the manifest to be invalid")
+	}
+	expected := []datacontract.Finding{
+		{Severity: datacontract.SeverityError, Line: 1, Column: 1, Message: `required: missing properties: ["kind"]`},
+		{Severity: datacontract.SeverityError, Path: "version", Line: 4, Column: 10, Message: `type: 1 has type "integer", want "string"`},
+		{Severity: datacontract.SeverityError, Path: "schema[0].properties[2].logicalType", Line: 23, Column: 22, Message: "enum: decimal does not equal any of: [string date number integer object array boolean]"},
+		{Severity: datacontract.SeverityError, Path: "slaProperties[1]", Line: 43, Column: 5, Message: `required: missing properties: ["property"]`},
+	}
+	errors := report.Errors()
+	if len(errors) != len(expected) {
+		t.Fatalf("Expected %d errors, got: %+v", len(expected), errors)
+	}
+	for i := range expected {
+		if errors[i] != expected[i] {
+			t.Errorf("Expected error %d to be %+v, got: %+v", i, expected[i], errors[i])
+		}
+	}
+}
+
+func TestValidate_Warnings(t *testing.T) {
+	report := datacontract.Validate([]byte("apiVersion: v3.0.0\nkind: DataContract\nid: c\nversion: 1.0.0\nstatus: draft\nschema:\n  - name: orders\n"))
+	if !report.Valid() {
+		t.Fatalf("Expected missing recommended fields not to make the manifest invalid, got: %+v", report.Errors())
+	}
+	var messages []string
+	for _, warning := range report.Warnings() {
+		messages = append(messages, warning.String())
+	}
+	expected := "line 1: missing recommended field 'name'|line 1: missing recommended field 'description'|line 1: missing recommended field 'team'|line 7 (schema[0]): missing recommended field 'properties'"
+	if strings.Join(messages, "|") != expected {
+		t.Errorf("Unexpected warnings: %v", messages)
+	}
+}
+
+func TestValidate_UnknownFields(t *testing.T) {
+	manifest := strings.Replace(readManifest(t, "odcs-v3.yaml"), "\n    quality:\n", "\n    qualityChecks:\n", 1)
+	manifest = strings.Replace(manifest, "domain: sales\n", "domain: sales\nowner: ann\n", 1)
+
+	report := datacontract.Validate([]byte(manifest))
+	var messages []string
+	for _, finding := range report.Errors() {
+		messages = append(messages, finding.String())
+	}
+	expected := "line 8 (owner): property 'owner' is not allowed|line 31 (schema[0].qualityChecks): property 'qualityChecks' is not allowed"
+	if strings.Join(messages, "|") != expected {
+		t.Errorf("Unexpected errors: %v", messages)
+	}
+}
+
+func TestValidate_Syntax(t *testing.T) {
+	for name, test := range map[string]struct {
+		manifest string
+		line     int
+	}{
+		"yaml":     {manifest: "apiVersion: v3.0.0\nkind: DataContract\n  id: [orders\n", line: 3},
+		"not ODCS": {manifest: "\n\t\tid: test-manifest-456\n\t\tapiVersion: 1.0.3\n", line: 2},
+		"scalar":   {manifest: "just text", line: 1},
+		"empty":    {manifest: "# nothing\n", line: 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			report := datacontract.Validate([]byte(test.manifest))
+			findings := report.Findings
+			if len(findings) != 1 || findings[0].Severity != datacontract.SeverityError || findings[0].Line != test.line || strings.HasPrefix(findings[0].Message, "yaml:") {
+				t.Errorf("Expected a single syntax error on line %d, got: %+v", test.line, findings)
+			}
+		})
+	}
+}
+
+func TestValidate_NotODCS(t *testing.T) {
+	for _, apiVersion := range []string{"1.0.3", "v3.1.0"} {
+		report := datacontract.Validate([]byte("id: test-manifest-123\nkind: DataContract\napiVersion: " + apiVersion + "\n"))
+		if !report.Valid() || report.Standard != "" || len(report.Warnings()) != 1 || report.Warnings()[0].Line != 3 {
+			t.Errorf("Expected a single warning about apiVersion %s, got: %+v", apiVersion, report)
+		}
+	}
+}
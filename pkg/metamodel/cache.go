@@ -0,0 +1,188 @@
+package metamodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Options configures a metamodel Cache
+type Options struct {
+	// TTL is the age after which the cached metamodel is refreshed. Zero means it is never refreshed.
+	TTL time.Duration
+	// Path is the file the metamodel is persisted to. Empty means the metamodel is only kept in memory.
+	Path string
+	// URL identifies the Collibra instance, so a persisted metamodel is never used for another instance.
+	URL string
+}
+
+type persistedMetamodel struct {
+	URL       string     `json:"url"`
+	Metamodel *Metamodel `json:"metamodel"`
+}
+
+// Cache keeps the metamodel of a Collibra instance in memory. The metamodel is loaded on first use, from disk
+// when persisted, otherwise from Collibra. Once older than the TTL, the cached metamodel keeps being served
+// while a refresh runs in the background, using the context of the request that noticed it had expired.
+type Cache struct {
+	client  *http.Client
+	options Options
+
+	mu         sync.Mutex
+	current    *Metamodel
+	loading    *pendingLoad
+	refreshing bool
+}
+
+// pendingLoad is a first load in progress, shared by the requests that need the metamodel meanwhile.
+type pendingLoad struct {
+	done chan struct{}
+	err  error
+}
+
+func NewCache(collibraHttpClient *http.Client, options Options) *Cache {
+	return &Cache{
+		client:  collibraHttpClient,
+		options: options,
+	}
+}
+
+// Get returns the cached metamodel, loading it if needed. The first load runs once, outside the lock, while the
+// other requests needing the metamodel wait for it or for their context to be done.
+func (c *Cache) Get(ctx context.Context) (*Metamodel, error) {
+	c.mu.Lock()
+	if c.current != nil {
+		current := c.current
+		if c.isExpired(current) && !c.refreshing {
+			c.refreshing = true
+			go c.refresh(context.WithoutCancel(ctx))
+		}
+		c.mu.Unlock()
+		return current, nil
+	}
+
+	pending := c.loading
+	if pending == nil {
+		pending = &pendingLoad{done: make(chan struct{})}
+		c.loading = pending
+		// The load outlives the request that started it, as other requests may be waiting for it.
+		go c.load(context.WithoutCancel(ctx), pending)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-pending.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if pending.err != nil {
+		return nil, pending.err
+	}
+	// Go through the cache again, so that a metamodel read from disk that has expired gets refreshed.
+	return c.Get(ctx)
+}
+
+// load reads the metamodel from disk, or loads it from Collibra, and publishes it.
+func (c *Cache) load(ctx context.Context, pending *pendingLoad) {
+	defer close(pending.done)
+
+	metamodel := c.readFromDisk()
+	if metamodel == nil {
+		var err error
+		if metamodel, err = Load(ctx, c.client); err != nil {
+			pending.err = fmt.Errorf("failed to load metamodel: %w", err)
+		} else {
+			c.writeToDisk(metamodel)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loading = nil
+	if pending.err == nil {
+		c.current = metamodel
+	}
+}
+
+// Refresh reloads the metamodel from Collibra and replaces the cached one.
+func (c *Cache) Refresh(ctx context.Context) (*Metamodel, error) {
+	metamodel, err := Load(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metamodel: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.current = metamodel
+	c.writeToDisk(metamodel)
+	return metamodel, nil
+}
+
+func (c *Cache) refresh(ctx context.Context) {
+	slog.InfoContext(ctx, "Refreshing metamodel in the background")
+	if _, err := c.Refresh(ctx); err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Failed to refresh metamodel, keeping the cached one: %v", err))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshing = false
+}
+
+func (c *Cache) isExpired(metamodel *Metamodel) bool {
+	return c.options.TTL > 0 && time.Since(metamodel.LoadedAt) > c.options.TTL
+}
+
+func (c *Cache) readFromDisk() *Metamodel {
+	if c.options.Path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.options.Path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Warn(fmt.Sprintf("Failed to read metamodel cache: %v", err))
+		}
+		return nil
+	}
+
+	var persisted persistedMetamodel
+	if err := json.Unmarshal(data, &persisted); err != nil || persisted.Metamodel == nil {
+		slog.Warn("Failed to parse metamodel cache, will reload the metamodel")
+		return nil
+	}
+
+	if persisted.URL != c.options.URL {
+		slog.Info("Cached metamodel is for a different URL, will reload the metamodel")
+		return nil
+	}
+
+	return persisted.Metamodel
+}
+
+func (c *Cache) writeToDisk(metamodel *Metamodel) {
+	if c.options.Path == "" {
+		return
+	}
+
+	data, err := json.Marshal(persistedMetamodel{URL: c.options.URL, Metamodel: metamodel})
+	if err != nil {
+		slog.Warn(fmt.Sprintf("Failed to marshal metamodel cache: %v", err))
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.options.Path), 0700); err != nil {
+		slog.Warn(fmt.Sprintf("Failed to create metamodel cache directory: %v", err))
+		return
+	}
+
+	if err := os.WriteFile(c.options.Path, data, 0600); err != nil {
+		slog.Warn(fmt.Sprintf("Failed to write metamodel cache: %v", err))
+	}
+}
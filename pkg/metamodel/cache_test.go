@@ -0,0 +1,224 @@
+package metamodel_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+)
+
+type testClient struct {
+	baseURL string
+	next    http.RoundTripper
+}
+
+func (c *testClient) RoundTrip(request *http.Request) (*http.Response, error) {
+	reqClone := request.Clone(request.Context())
+	baseURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	reqClone.URL.Scheme = baseURL.Scheme
+	reqClone.URL.Host = baseURL.Host
+	return c.next.RoundTrip(reqClone)
+}
+
+func newMetamodelServer(t *testing.T, assetTypeName string, loads *atomic.Int32) (*httptest.Server, *http.Client) {
+	handler := http.NewServeMux()
+	respond := func(w http.ResponseWriter, response any) {
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}
+	handler.HandleFunc("/rest/2.0/assetTypes", func(w http.ResponseWriter, r *http.Request) {
+		loads.Add(1)
+		respond(w, clients.AssetTypePagedResponse{
+			Total:   1,
+			Results: []clients.AssetTypeDetails{{ID: "00000000-0000-0000-0000-000000031007", Name: assetTypeName, PublicId: "Table"}},
+		})
+	})
+	handler.HandleFunc("/rest/2.0/attributeTypes", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, clients.AttributeTypePagedResponse{
+			Total:   1,
+			Results: []clients.AttributeTypeDetails{{ID: "00000000-0000-0000-0000-000000003114", Name: "Definition", Kind: "STRING"}},
+		})
+	})
+	handler.HandleFunc("/rest/2.0/relationTypes", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, clients.RelationTypePagedResponse{
+			Total:   1,
+			Results: []clients.RelationTypeDetails{{ID: "00000000-0000-0000-0000-000000007042", Role: "contains", CoRole: "is part of"}},
+		})
+	})
+	handler.HandleFunc("/rest/2.0/statuses", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, clients.StatusPagedResponse{
+			Total:   1,
+			Results: []clients.StatusDetails{{ID: "00000000-0000-0000-0000-000000005009", Name: "Accepted"}},
+		})
+	})
+	handler.HandleFunc("/rest/2.0/domainTypes", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, clients.DomainTypePagedResponse{
+			Total:   1,
+			Results: []clients.DomainTypeDetails{{ID: "00000000-0000-0000-0000-000000030001", Name: "Glossary"}},
+		})
+	})
+
+	server := httptest.NewServer(handler)
+	return server, &http.Client{Transport: &testClient{baseURL: server.URL, next: http.DefaultTransport}}
+}
+
+func TestCache_LoadsOnce(t *testing.T) {
+	var loads atomic.Int32
+	server, client := newMetamodelServer(t, "Table", &loads)
+	defer server.Close()
+
+	cache := metamodel.NewCache(client, metamodel.Options{})
+	for range 3 {
+		mm, err := cache.Get(t.Context())
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(mm.AssetTypesByName("table")) != 1 {
+			t.Fatalf("Expected asset type 'Table' to be found by name")
+		}
+	}
+
+	if loads.Load() != 1 {
+		t.Fatalf("Expected the metamodel to be loaded once, got: %d", loads.Load())
+	}
+}
+
+func TestCache_Lookups(t *testing.T) {
+	var loads atomic.Int32
+	server, client := newMetamodelServer(t, "Table", &loads)
+	defer server.Close()
+
+	mm, err := metamodel.NewCache(client, metamodel.Options{}).Get(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, ok := mm.AttributeTypeByID("00000000-0000-0000-0000-000000003114"); !ok {
+		t.Errorf("Expected attribute type to be found by ID")
+	}
+	if len(mm.RelationTypesByRole("is part of")) != 1 {
+		t.Errorf("Expected relation type to be found by co-role")
+	}
+	if len(mm.StatusesByName("ACCEPTED")) != 1 {
+		t.Errorf("Expected status to be found by name")
+	}
+	if _, ok := mm.DomainTypeByID("00000000-0000-0000-0000-000000030001"); !ok {
+		t.Errorf("Expected domain type to be found by ID")
+	}
+}
+
+func TestCache_PersistsToDisk(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metamodel.json")
+
+	var loads atomic.Int32
+	server, client := newMetamodelServer(t, "Table", &loads)
+	if _, err := metamodel.NewCache(client, metamodel.Options{Path: path, URL: "https://collibra.example.com"}).Get(t.Context()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	server.Close()
+
+	offline := &http.Client{Transport: &testClient{baseURL: server.URL, next: http.DefaultTransport}}
+	mm, err := metamodel.NewCache(offline, metamodel.Options{Path: path, URL: "https://collibra.example.com"}).Get(t.Context())
+	if err != nil {
+		t.Fatalf("Expected the metamodel to be read from disk, got: %v", err)
+	}
+	if len(mm.AssetTypes) != 1 {
+		t.Fatalf("Expected 1 asset type, got: %d", len(mm.AssetTypes))
+	}
+
+	if _, err := metamodel.NewCache(offline, metamodel.Options{Path: path, URL: "https://other.example.com"}).Get(t.Context()); err == nil {
+		t.Fatalf("Expected a metamodel persisted for another instance to be ignored")
+	}
+}
+
+func TestCache_RefreshesInBackground(t *testing.T) {
+	var loads atomic.Int32
+	server, client := newMetamodelServer(t, "Table", &loads)
+	defer server.Close()
+
+	cache := metamodel.NewCache(client, metamodel.Options{TTL: time.Millisecond})
+	first, err := cache.Get(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	stale, err := cache.Get(t.Context())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if stale != first {
+		t.Fatalf("Expected the expired metamodel to be served while refreshing")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for loads.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if loads.Load() < 2 {
+		t.Fatalf("Expected the metamodel to be refreshed in the background")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestCache_LoadsOutsideTheLock(t *testing.T) {
+	var loads atomic.Int32
+	server, client := newMetamodelServer(t, "Table", &loads)
+	defer server.Close()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	next := client.Transport
+	client.Transport = roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		once.Do(func() {
+			close(started)
+			<-release
+		})
+		return next.RoundTrip(request)
+	})
+
+	cache := metamodel.NewCache(client, metamodel.Options{})
+	results := make(chan error, 2)
+	get := func() {
+		_, err := cache.Get(t.Context())
+		results <- err
+	}
+	go get()
+	<-started
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := cache.Get(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a request to give up waiting for the first load when its context is done, got: %v", err)
+	}
+
+	go get()
+	close(release)
+	for range 2 {
+		if err := <-results; err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+	if loads.Load() != 1 {
+		t.Fatalf("Expected the metamodel to be loaded once, got: %d", loads.Load())
+	}
+}
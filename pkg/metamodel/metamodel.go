@@ -0,0 +1,177 @@
+package metamodel
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/collibra/chip/pkg/clients"
+)
+
+const pageSize = 1000
+
+// Metamodel is a snapshot of the asset types, attribute types, relation types, statuses and domain types
+// of a Collibra instance.
+type Metamodel struct {
+	AssetTypes     []clients.AssetTypeDetails     `json:"assetTypes"`
+	AttributeTypes []clients.AttributeTypeDetails `json:"attributeTypes"`
+	RelationTypes  []clients.RelationTypeDetails  `json:"relationTypes"`
+	Statuses       []clients.StatusDetails        `json:"statuses"`
+	DomainTypes    []clients.DomainTypeDetails    `json:"domainTypes"`
+	LoadedAt       time.Time                      `json:"loadedAt"`
+}
+
+// Load fetches the complete metamodel from Collibra.
+func Load(ctx context.Context, collibraHttpClient *http.Client) (*Metamodel, error) {
+	assetTypes, err := loadAll(func(limit int, offset int) ([]clients.AssetTypeDetails, int64, error) {
+		page, err := clients.ListAssetTypes(ctx, collibraHttpClient, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attributeTypes, err := loadAll(func(limit int, offset int) ([]clients.AttributeTypeDetails, int64, error) {
+		page, err := clients.ListAttributeTypes(ctx, collibraHttpClient, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	relationTypes, err := loadAll(func(limit int, offset int) ([]clients.RelationTypeDetails, int64, error) {
+		page, err := clients.ListRelationTypes(ctx, collibraHttpClient, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := loadAll(func(limit int, offset int) ([]clients.StatusDetails, int64, error) {
+		page, err := clients.ListStatuses(ctx, collibraHttpClient, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	domainTypes, err := loadAll(func(limit int, offset int) ([]clients.DomainTypeDetails, int64, error) {
+		page, err := clients.ListDomainTypes(ctx, collibraHttpClient, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metamodel{
+		AssetTypes:     assetTypes,
+		AttributeTypes: attributeTypes,
+		RelationTypes:  relationTypes,
+		Statuses:       statuses,
+		DomainTypes:    domainTypes,
+		LoadedAt:       time.Now(),
+	}, nil
+}
+
+func loadAll[T any](fetch func(limit int, offset int) ([]T, int64, error)) ([]T, error) {
+	all := []T{}
+	for offset := 0; ; offset += pageSize {
+		results, total, err := fetch(pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, results...)
+		if len(results) < pageSize || int64(len(all)) >= total {
+			return all, nil
+		}
+	}
+}
+
+func (m *Metamodel) AssetTypeByID(id string) (clients.AssetTypeDetails, bool) {
+	return findOne(m.AssetTypes, func(t clients.AssetTypeDetails) bool { return t.ID == id })
+}
+
+// AssetTypesByName returns the asset types whose name or public ID equals the given name, ignoring case.
+func (m *Metamodel) AssetTypesByName(name string) []clients.AssetTypeDetails {
+	return findAll(m.AssetTypes, func(t clients.AssetTypeDetails) bool {
+		return strings.EqualFold(t.Name, name) || strings.EqualFold(t.PublicId, name)
+	})
+}
+
+func (m *Metamodel) AttributeTypeByID(id string) (clients.AttributeTypeDetails, bool) {
+	return findOne(m.AttributeTypes, func(t clients.AttributeTypeDetails) bool { return t.ID == id })
+}
+
+// AttributeTypesByName returns the attribute types whose name or public ID equals the given name, ignoring case.
+func (m *Metamodel) AttributeTypesByName(name string) []clients.AttributeTypeDetails {
+	return findAll(m.AttributeTypes, func(t clients.AttributeTypeDetails) bool {
+		return strings.EqualFold(t.Name, name) || strings.EqualFold(t.PublicId, name)
+	})
+}
+
+func (m *Metamodel) RelationTypeByID(id string) (clients.RelationTypeDetails, bool) {
+	return findOne(m.RelationTypes, func(t clients.RelationTypeDetails) bool { return t.ID == id })
+}
+
+// RelationTypesByRole returns the relation types whose role or co-role equals the given role, ignoring case.
+func (m *Metamodel) RelationTypesByRole(role string) []clients.RelationTypeDetails {
+	return findAll(m.RelationTypes, func(t clients.RelationTypeDetails) bool {
+		return strings.EqualFold(t.Role, role) || strings.EqualFold(t.CoRole, role)
+	})
+}
+
+func (m *Metamodel) StatusByID(id string) (clients.StatusDetails, bool) {
+	return findOne(m.Statuses, func(s clients.StatusDetails) bool { return s.ID == id })
+}
+
+// StatusesByName returns the statuses whose name equals the given name, ignoring case.
+func (m *Metamodel) StatusesByName(name string) []clients.StatusDetails {
+	return findAll(m.Statuses, func(s clients.StatusDetails) bool { return strings.EqualFold(s.Name, name) })
+}
+
+func (m *Metamodel) DomainTypeByID(id string) (clients.DomainTypeDetails, bool) {
+	return findOne(m.DomainTypes, func(t clients.DomainTypeDetails) bool { return t.ID == id })
+}
+
+// DomainTypesByName returns the domain types whose name or public ID equals the given name, ignoring case.
+func (m *Metamodel) DomainTypesByName(name string) []clients.DomainTypeDetails {
+	return findAll(m.DomainTypes, func(t clients.DomainTypeDetails) bool {
+		return strings.EqualFold(t.Name, name) || strings.EqualFold(t.PublicId, name)
+	})
+}
+
+func findOne[T any](items []T, match func(T) bool) (T, bool) {
+	for _, item := range items {
+		if match(item) {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func findAll[T any](items []T, match func(T) bool) []T {
+	var matches []T
+	for _, item := range items {
+		if match(item) {
+			matches = append(matches, item)
+		}
+	}
+	return matches
+}
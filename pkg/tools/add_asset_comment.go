@@ -0,0 +1,110 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type AddAssetCommentInput struct {
+	AssetID         string   `json:"assetId" jsonschema:"Required. The UUID of the asset to comment on."`
+	Content         string   `json:"content" jsonschema:"Required. The text of the comment."`
+	ParentCommentID string   `json:"parentCommentId,omitempty" jsonschema:"Optional. The UUID of the comment to reply to, as returned by asset_comments_list. Leave empty to start a new thread."`
+	Mentions        []string `json:"mentions,omitempty" jsonschema:"Optional. Users to mention in the comment, given as user names, email addresses, full names or UUIDs."`
+}
+
+type AddAssetCommentOutput struct {
+	Comment *clients.Comment `json:"comment,omitempty" jsonschema:"The created comment"`
+	Success bool             `json:"success" jsonschema:"Whether the comment was successfully posted"`
+	Error   string           `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+}
+
+func NewAddAssetCommentTool(collibraClient *http.Client, agentMarker string) *chip.Tool[AddAssetCommentInput, AddAssetCommentOutput] {
+	return &chip.Tool[AddAssetCommentInput, AddAssetCommentOutput]{
+		Name:        "asset_comment_add",
+		Description: "Post a comment on an asset, or reply to an existing comment, optionally mentioning users. The comment is marked as written by an AI agent so people can tell it apart.",
+		Handler:     handleAddAssetComment(collibraClient, clients.NewUserCache(collibraClient), agentMarker),
+	}
+}
+
+func handleAddAssetComment(collibraClient *http.Client, userCache *clients.UserCache, agentMarker string) chip.ToolHandlerFunc[AddAssetCommentInput, AddAssetCommentOutput] {
+	return func(ctx context.Context, input AddAssetCommentInput) (AddAssetCommentOutput, error) {
+		output, isNotValid := validateAddAssetCommentInput(input)
+		if isNotValid {
+			return output, nil
+		}
+
+		userIDs, err := resolveUserNames(ctx, userCache, input.Mentions)
+		if err != nil {
+			return AddAssetCommentOutput{Success: false, Error: err.Error()}, nil
+		}
+		mentions := make([]clients.UserDetails, len(userIDs))
+		for i, userID := range userIDs {
+			user, err := clients.GetUser(ctx, collibraClient, userID)
+			if err != nil {
+				return AddAssetCommentOutput{Success: false, Error: fmt.Sprintf("Failed to look up mentioned user %s: %s", userID, err.Error())}, nil
+			}
+			mentions[i] = *user
+		}
+
+		comment, err := clients.AddComment(ctx, collibraClient, clients.AddCommentRequest{
+			Content:      renderComment(input.Content, mentions, agentMarker),
+			BaseResource: clients.NamedResourceReference{ID: input.AssetID, ResourceType: "Asset"},
+			ParentID:     input.ParentCommentID,
+		})
+		if err != nil {
+			return AddAssetCommentOutput{Success: false, Error: fmt.Sprintf("Failed to add comment: %s", err.Error())}, nil
+		}
+
+		return AddAssetCommentOutput{
+			Comment: comment,
+			Success: true,
+		}, nil
+	}
+}
+
+// renderComment turns the text into the HTML content of a Collibra comment, with the mentions in front and the
+// agent marker at the end.
+func renderComment(text string, mentions []clients.UserDetails, agentMarker string) string {
+	var content strings.Builder
+	content.WriteString("<p>")
+	for _, user := range mentions {
+		content.WriteString(fmt.Sprintf(`<span class="mention" data-mention-id="%s" data-mention-type="user">@%s</span> `, user.ID, html.EscapeString(user.FullName())))
+	}
+	paragraphs := strings.Split(strings.TrimSpace(text), "\n")
+	for i, paragraph := range paragraphs {
+		if i > 0 {
+			content.WriteString("<br/>")
+		}
+		content.WriteString(html.EscapeString(paragraph))
+	}
+	content.WriteString("</p>")
+	if agentMarker != "" {
+		content.WriteString("<p><em>" + html.EscapeString(agentMarker) + "</em></p>")
+	}
+	return content.String()
+}
+
+func validateAddAssetCommentInput(input AddAssetCommentInput) (AddAssetCommentOutput, bool) {
+	if _, err := uuid.Parse(input.AssetID); err != nil {
+		return AddAssetCommentOutput{Success: false, Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error())}, true
+	}
+
+	if strings.TrimSpace(input.Content) == "" {
+		return AddAssetCommentOutput{Success: false, Error: "Comment content is required"}, true
+	}
+
+	if input.ParentCommentID != "" {
+		if _, err := uuid.Parse(input.ParentCommentID); err != nil {
+			return AddAssetCommentOutput{Success: false, Error: fmt.Sprintf("Invalid parent comment ID format: %s", err.Error())}, true
+		}
+	}
+
+	return AddAssetCommentOutput{}, false
+}
@@ -0,0 +1,74 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestAddAssetComment(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	parentId := "5b3f6a55-2f42-4c43-9a3e-9b6ab1f0e4c2"
+	userId := "0f6e1c9a-6b4c-4c29-9a43-1f9d0b6f0a11"
+
+	var posted clients.AddCommentRequest
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/users", JsonHandlerOut(func(r *http.Request) (int, clients.UserPagedResponse) {
+		return http.StatusOK, clients.UserPagedResponse{
+			Results: []clients.UserDetails{{ID: userId, UserName: "jdoe", FirstName: "John", LastName: "Doe"}},
+		}
+	}))
+	handler.Handle("/rest/2.0/users/"+userId, JsonHandlerOut(func(r *http.Request) (int, clients.UserDetails) {
+		return http.StatusOK, clients.UserDetails{ID: userId, UserName: "jdoe", FirstName: "John", LastName: "Doe"}
+	}))
+	handler.Handle("POST /rest/2.0/comments", JsonHandlerInOut(func(r *http.Request, request clients.AddCommentRequest) (int, clients.Comment) {
+		posted = request
+		return http.StatusCreated, clients.Comment{ID: "c3", Content: request.Content, BaseResource: request.BaseResource}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAddAssetCommentTool(newClient(server), "[Posted by an AI agent]").Handler(t.Context(), tools.AddAssetCommentInput{
+		AssetID:         assetId,
+		Content:         "The column holds <emails>.",
+		ParentCommentID: parentId,
+		Mentions:        []string{"jdoe"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected success, got: %+v", output)
+	}
+
+	if posted.BaseResource.ID != assetId || posted.ParentID != parentId {
+		t.Errorf("Expected a reply on the asset, got: %+v", posted)
+	}
+	for _, expected := range []string{
+		`data-mention-id="` + userId + `"`,
+		"@John Doe",
+		"The column holds &lt;emails&gt;.",
+		"<em>[Posted by an AI agent]</em>",
+	} {
+		if !strings.Contains(posted.Content, expected) {
+			t.Errorf("Expected comment content to contain '%s', got: %s", expected, posted.Content)
+		}
+	}
+}
+
+func TestAddAssetComment_MissingContent(t *testing.T) {
+	output, err := tools.NewAddAssetCommentTool(&http.Client{}, "").Handler(t.Context(), tools.AddAssetCommentInput{
+		AssetID: "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || output.Error != "Comment content is required" {
+		t.Fatalf("Expected a validation error, got: %+v", output)
+	}
+}
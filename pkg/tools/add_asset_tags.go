@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type AddAssetTagsInput struct {
+	AssetID string   `json:"assetId" jsonschema:"Required. The UUID of the asset to tag."`
+	Tags    []string `json:"tags" jsonschema:"Required. The names of the tags to add. Tags that do not exist yet are created."`
+}
+
+type AddAssetTagsOutput struct {
+	Tags    []string `json:"tags,omitempty" jsonschema:"The names of the tags on the asset after adding"`
+	Success bool     `json:"success" jsonschema:"Whether the tags were successfully added"`
+	Error   string   `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+}
+
+func NewAddAssetTagsTool(collibraClient *http.Client) *chip.Tool[AddAssetTagsInput, AddAssetTagsOutput] {
+	return &chip.Tool[AddAssetTagsInput, AddAssetTagsOutput]{
+		Name:        "asset_tags_add",
+		Description: "Add one or more tags to an asset. Tags that do not exist yet are created.",
+		Handler:     handleAddAssetTags(collibraClient),
+	}
+}
+
+func handleAddAssetTags(collibraClient *http.Client) chip.ToolHandlerFunc[AddAssetTagsInput, AddAssetTagsOutput] {
+	return func(ctx context.Context, input AddAssetTagsInput) (AddAssetTagsOutput, error) {
+		if _, err := uuid.Parse(input.AssetID); err != nil {
+			return AddAssetTagsOutput{Success: false, Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error())}, nil
+		}
+
+		names := cleanTagNames(input.Tags)
+		if len(names) == 0 {
+			return AddAssetTagsOutput{Success: false, Error: "At least one tag is required"}, nil
+		}
+
+		tags, err := clients.AddAssetTags(ctx, collibraClient, input.AssetID, names)
+		if err != nil {
+			return AddAssetTagsOutput{Success: false, Error: fmt.Sprintf("Failed to add tags: %s", err.Error())}, nil
+		}
+
+		return AddAssetTagsOutput{Tags: tagNames(tags), Success: true}, nil
+	}
+}
+
+func cleanTagNames(tags []string) []string {
+	var names []string
+	for _, tag := range tags {
+		if name := strings.TrimSpace(tag); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
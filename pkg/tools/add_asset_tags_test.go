@@ -0,0 +1,54 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestAddAssetTags(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+
+	var posted clients.AssetTagsRequest
+	handler := http.NewServeMux()
+	handler.Handle("POST /rest/2.0/assets/"+assetId+"/tags", JsonHandlerInOut(func(r *http.Request, request clients.AssetTagsRequest) (int, []clients.Tag) {
+		posted = request
+		return http.StatusOK, []clients.Tag{{ID: "t1", Name: "PII-reviewed"}, {ID: "t2", Name: "deprecated"}}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAddAssetTagsTool(newClient(server)).Handler(t.Context(), tools.AddAssetTagsInput{
+		AssetID: assetId,
+		Tags:    []string{" deprecated ", ""},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected success, got: %+v", output)
+	}
+	if !slices.Equal(posted.TagNames, []string{"deprecated"}) {
+		t.Errorf("Expected the trimmed tag names to be posted, got: %v", posted.TagNames)
+	}
+	if !slices.Equal(output.Tags, []string{"PII-reviewed", "deprecated"}) {
+		t.Errorf("Unexpected tags: %v", output.Tags)
+	}
+}
+
+func TestAddAssetTags_NoTags(t *testing.T) {
+	output, err := tools.NewAddAssetTagsTool(&http.Client{}).Handler(t.Context(), tools.AddAssetTagsInput{
+		AssetID: "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || output.Error != "At least one tag is required" {
+		t.Fatalf("Expected a validation error, got: %+v", output)
+	}
+}
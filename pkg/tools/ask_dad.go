@@ -9,28 +9,30 @@ import (
 )
 
 type AskDadInput struct {
-	Question string `json:"input" jsonschema:"the question to ask the data asset discovery agent"`
+	Question  string `json:"input" jsonschema:"the question to ask the data asset discovery agent"`
+	OriginUrl string `json:"originUrl,omitempty" jsonschema:"Optional. The URL or UUID of the asset the question is about, used by the agent for grounding."`
 }
 
 type AskDadOutput struct {
-	Answer string `json:"output" jsonschema:"the answer from the data asset discovery agent"`
+	Answer    string            `json:"output" jsonschema:"the answer from the data asset discovery agent"`
+	Citations []CopilotCitation `json:"citations" jsonschema:"the assets and links the answer is based on"`
 }
 
-func NewAskDadTool(collibraClient *http.Client) *chip.Tool[AskDadInput, AskDadOutput] {
+func NewAskDadTool(collibraClient *http.Client, history *CopilotHistory) *chip.Tool[AskDadInput, AskDadOutput] {
 	return &chip.Tool[AskDadInput, AskDadOutput]{
 		Name:        "data_assets_discover",
-		Description: "Ask the data asset discovery agent questions about available data assets in Collibra.",
-		Handler:     handleAskDad(collibraClient),
+		Description: "Ask the data asset discovery agent questions about available data assets in Collibra. The answer comes with the assets it cites, to verify or look into. The agent remembers the earlier questions of this session, so follow-up questions can refer to them; use copilot_history_reset to start over.",
+		Handler:     handleAskDad(collibraClient, history),
 	}
 }
 
-func handleAskDad(collibraClient *http.Client) chip.ToolHandlerFunc[AskDadInput, AskDadOutput] {
+func handleAskDad(collibraClient *http.Client, history *CopilotHistory) chip.ToolHandlerFunc[AskDadInput, AskDadOutput] {
 	return func(ctx context.Context, input AskDadInput) (AskDadOutput, error) {
-		response, err := clients.AskDad(ctx, collibraClient, input.Question)
+		answer, err := askCopilot(ctx, collibraClient, history, "data_assets_discover", input.Question, input.OriginUrl, clients.AskDad)
 		if err != nil {
 			return AskDadOutput{}, err
 		}
 
-		return AskDadOutput{Answer: response}, nil
+		return AskDadOutput{Answer: answer.Text, Citations: answer.Citations}, nil
 	}
 }
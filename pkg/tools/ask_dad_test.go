@@ -1,13 +1,20 @@
 package tools_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/collibra/chip/pkg/chip"
 	"github.com/collibra/chip/pkg/clients"
 	"github.com/collibra/chip/pkg/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 func TestAskDad(t *testing.T) {
@@ -24,7 +31,7 @@ func TestAskDad(t *testing.T) {
 	defer server.Close()
 
 	client := newClient(server)
-	output, err := tools.NewAskDadTool(client).Handler(t.Context(), tools.AskDadInput{
+	output, err := tools.NewAskDadTool(client, tools.NewCopilotHistory(10)).Handler(t.Context(), tools.AskDadInput{
 		Question: "Column names with PII in table users?",
 	})
 	if err != nil {
@@ -36,3 +43,119 @@ func TestAskDad(t *testing.T) {
 		t.Fatalf("Expected answer '%s', got: '%s'", expectedAnswer, output.Answer)
 	}
 }
+
+func TestAskDad_FollowUp(t *testing.T) {
+	var requests []clients.ToolRequest
+	handler := http.NewServeMux()
+	handler.Handle("/rest/aiCopilot/v1/tools/askDad", JsonHandlerInOut(func(_ *http.Request, request clients.ToolRequest) (int, clients.ToolResponse) {
+		requests = append(requests, request)
+		return http.StatusOK, clients.ToolResponse{
+			Content: []clients.ToolContent{{Text: fmt.Sprintf("Answer %d", len(requests))}},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	ctx := chip.SetCollibraHost(chip.SetCallToolRequest(t.Context(), &mcp.CallToolRequest{Session: &mcp.ServerSession{}}), "https://collibra.example.com")
+	tool := tools.NewAskDadTool(client, tools.NewCopilotHistory(10))
+	if _, err := tool.Handler(ctx, tools.AskDadInput{Question: "Which tables hold customer data?", OriginUrl: "11111111-1111-1111-1111-111111111111"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := tool.Handler(ctx, tools.AskDadInput{Question: "Which of them are certified?"}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if requests[0].Message.Context.OriginUrl != "https://collibra.example.com/asset/11111111-1111-1111-1111-111111111111" {
+		t.Errorf("Expected the origin asset URL to be sent, got: '%s'", requests[0].Message.Context.OriginUrl)
+	}
+	history := requests[1].History
+	if len(history) != 2 {
+		t.Fatalf("Expected the first question and answer to be sent along, got: %+v", history)
+	}
+	if history[0].MessagerRole != "user" || history[0].Content.Text != "Which tables hold customer data?" {
+		t.Errorf("Unexpected question in history: %+v", history[0])
+	}
+	if history[1].MessagerRole != "assistant" || history[1].Content.Text != "Answer 1" {
+		t.Errorf("Unexpected answer in history: %+v", history[1])
+	}
+}
+
+func TestAskDad_Streaming(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/rest/aiCopilot/v1/tools/askDad", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			t.Errorf("Expected the request to accept a stream, got: %s", r.Header.Get("Accept"))
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range []string{
+			`data: {"content":[{"type":"text","text":"Customer data is in "}]}`,
+			`data: {"content":[{"type":"text","text":"the CRM tables."}]}`,
+			`data: {"content":[{"type":"asset","id":"11111111-1111-1111-1111-111111111111","name":"crm.customers"}]}`,
+			`data: [DONE]`,
+		} {
+			_, _ = fmt.Fprintf(w, "%s\n\n", event)
+			w.(http.Flusher).Flush()
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	chipServer := chip.NewServer()
+	chip.RegisterTool(chipServer, tools.NewAskDadTool(newClient(server), tools.NewCopilotHistory(10)))
+
+	var mu sync.Mutex
+	var progress []string
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	if _, err := chipServer.Connect(t.Context(), serverTransport, nil); err != nil {
+		t.Fatalf("Failed to connect server: %v", err)
+	}
+	mcpClient := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "v0.0.1"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(_ context.Context, request *mcp.ProgressNotificationClientRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			progress = append(progress, request.Params.Message)
+		},
+	})
+	session, err := mcpClient.Connect(t.Context(), clientTransport, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	defer func() { _ = session.Close() }()
+
+	params := &mcp.CallToolParams{
+		Meta:      mcp.Meta{"progressToken": "ask"},
+		Name:      "data_assets_discover",
+		Arguments: map[string]any{"input": "Where is customer data?"},
+	}
+	result, err := session.CallTool(t.Context(), params)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	output, ok := result.StructuredContent.(map[string]any)
+	if !ok {
+		t.Fatalf("Expected structured output, got: %+v", result)
+	}
+	if output["output"] != "Customer data is in the CRM tables." {
+		t.Errorf("Unexpected answer: %v", output["output"])
+	}
+	if citations, _ := output["citations"].([]any); len(citations) != 1 {
+		t.Errorf("Expected the streamed asset to be cited, got: %v", output["citations"])
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		mu.Lock()
+		received := append([]string{}, progress...)
+		mu.Unlock()
+		if len(received) == 2 || time.Now().After(deadline) {
+			if !reflect.DeepEqual(received, []string{"Customer data is in ", "the CRM tables."}) {
+				t.Errorf("Expected the partial answers as progress, got: %q", received)
+			}
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -9,27 +9,29 @@ import (
 )
 
 type AskGlossaryInput struct {
-	Question string `json:"input" jsonschema:"the question to ask the business glossary agent"`
+	Question  string `json:"input" jsonschema:"the question to ask the business glossary agent"`
+	OriginUrl string `json:"originUrl,omitempty" jsonschema:"Optional. The URL or UUID of the asset the question is about, used by the agent for grounding."`
 }
 
 type AskGlossaryOutput struct {
-	Answer string `json:"output" jsonschema:"the answer from the business glossary agent"`
+	Answer    string            `json:"output" jsonschema:"the answer from the business glossary agent"`
+	Citations []CopilotCitation `json:"citations" jsonschema:"the assets and links the answer is based on"`
 }
 
-func NewAskGlossaryTool(collibraHttpClient *http.Client) *chip.Tool[AskGlossaryInput, AskGlossaryOutput] {
+func NewAskGlossaryTool(collibraHttpClient *http.Client, history *CopilotHistory) *chip.Tool[AskGlossaryInput, AskGlossaryOutput] {
 	return &chip.Tool[AskGlossaryInput, AskGlossaryOutput]{
 		Name:        "business_glossary_discover",
-		Description: "Ask the business glossary agent questions about terms and definitions in Collibra.",
-		Handler:     handleAskGlossary(collibraHttpClient),
+		Description: "Ask the business glossary agent questions about terms and definitions in Collibra. The answer comes with the assets it cites, to verify or look into. The agent remembers the earlier questions of this session, so follow-up questions can refer to them; use copilot_history_reset to start over.",
+		Handler:     handleAskGlossary(collibraHttpClient, history),
 	}
 }
 
-func handleAskGlossary(collibraClient *http.Client) chip.ToolHandlerFunc[AskGlossaryInput, AskGlossaryOutput] {
+func handleAskGlossary(collibraClient *http.Client, history *CopilotHistory) chip.ToolHandlerFunc[AskGlossaryInput, AskGlossaryOutput] {
 	return func(ctx context.Context, input AskGlossaryInput) (AskGlossaryOutput, error) {
-		response, err := clients.AskGlossary(ctx, collibraClient, input.Question)
+		answer, err := askCopilot(ctx, collibraClient, history, "business_glossary_discover", input.Question, input.OriginUrl, clients.AskGlossary)
 		if err != nil {
 			return AskGlossaryOutput{}, err
 		}
-		return AskGlossaryOutput{Answer: response}, nil
+		return AskGlossaryOutput{Answer: answer.Text, Citations: answer.Citations}, nil
 	}
 }
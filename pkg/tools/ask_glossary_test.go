@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
+	"github.com/collibra/chip/pkg/chip"
 	"github.com/collibra/chip/pkg/clients"
 	"github.com/collibra/chip/pkg/tools"
 )
@@ -24,7 +26,7 @@ func TestAskGlossary(t *testing.T) {
 	defer server.Close()
 
 	client := newClient(server)
-	output, err := tools.NewAskGlossaryTool(client).Handler(t.Context(), tools.AskGlossaryInput{
+	output, err := tools.NewAskGlossaryTool(client, tools.NewCopilotHistory(10)).Handler(t.Context(), tools.AskGlossaryInput{
 		Question: "What is the definition of ARR?",
 	})
 	if err != nil {
@@ -36,3 +38,43 @@ func TestAskGlossary(t *testing.T) {
 		t.Fatalf("Expected answer '%s', got: '%s'", expectedAnswer, output.Answer)
 	}
 }
+
+func TestAskGlossary_Citations(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.Handle("/rest/aiCopilot/v1/tools/askGlossary", JsonHandlerInOut(func(_ *http.Request, request clients.ToolRequest) (int, clients.ToolResponse) {
+		return http.StatusOK, clients.ToolResponse{
+			Content: []clients.ToolContent{
+				{Type: "text", Text: "ARR is defined in [Annual Recurring Revenue](https://collibra.example.com/asset/22222222-2222-2222-2222-222222222222)."},
+				{Type: "text", Text: "See also the finance policy."},
+				{Type: "asset", ID: "11111111-1111-1111-1111-111111111111", Name: "Revenue"},
+				{Type: "asset", ID: "22222222-2222-2222-2222-222222222222", Name: "Annual Recurring Revenue"},
+				{Type: "link", Name: "Finance policy", Url: "https://intranet.example.com/finance"},
+			},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	ctx := chip.SetCollibraHost(t.Context(), "https://collibra.example.com")
+	output, err := tools.NewAskGlossaryTool(client, tools.NewCopilotHistory(10)).Handler(ctx, tools.AskGlossaryInput{
+		Question: "What is the definition of ARR?",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expectedAnswer := "ARR is defined in [Annual Recurring Revenue](https://collibra.example.com/asset/22222222-2222-2222-2222-222222222222).\n\nSee also the finance policy."
+	if output.Answer != expectedAnswer {
+		t.Errorf("Expected answer '%s', got: '%s'", expectedAnswer, output.Answer)
+	}
+	expectedCitations := []tools.CopilotCitation{
+		{AssetID: "11111111-1111-1111-1111-111111111111", Name: "Revenue", Url: "https://collibra.example.com/asset/11111111-1111-1111-1111-111111111111"},
+		{AssetID: "22222222-2222-2222-2222-222222222222", Name: "Annual Recurring Revenue", Url: "https://collibra.example.com/asset/22222222-2222-2222-2222-222222222222"},
+		{Name: "Finance policy", Url: "https://intranet.example.com/finance"},
+	}
+	if !reflect.DeepEqual(output.Citations, expectedCitations) {
+		t.Errorf("Expected citations %+v, got: %+v", expectedCitations, output.Citations)
+	}
+}
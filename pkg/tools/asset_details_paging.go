@@ -0,0 +1,217 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/collibra/chip/pkg/clients"
+)
+
+// AssetDetailsPage describes how much of a section of an asset was returned.
+type AssetDetailsPage struct {
+	Section    string `json:"section" jsonschema:"The section: stringAttributes, numericAttributes, booleanAttributes, dateAttributes, outgoingRelations or incomingRelations"`
+	Returned   int    `json:"returned" jsonschema:"The number of items of the section that were returned"`
+	HasMore    bool   `json:"hasMore" jsonschema:"Whether the section has more items than were returned. A full page of 1000 items always reports more items, the next page being empty if there are none."`
+	NextCursor string `json:"nextCursor,omitempty" jsonschema:"Pass this in cursors under the section name to fetch the next page of the section"`
+}
+
+// assetSection gives uniform access to the items of one section of an asset.
+type assetSection interface {
+	name() string
+	length(asset *clients.Asset) int
+	lastID(asset *clients.Asset) string
+	truncate(asset *clients.Asset, n int)
+	extend(asset *clients.Asset, page *clients.Asset)
+}
+
+type assetSectionOf[T any] struct {
+	section string
+	items   func(asset *clients.Asset) *[]T
+	id      func(item T) string
+}
+
+func (s assetSectionOf[T]) name() string {
+	return s.section
+}
+
+func (s assetSectionOf[T]) length(asset *clients.Asset) int {
+	return len(*s.items(asset))
+}
+
+func (s assetSectionOf[T]) lastID(asset *clients.Asset) string {
+	items := *s.items(asset)
+	if len(items) == 0 {
+		return ""
+	}
+	return s.id(items[len(items)-1])
+}
+
+func (s assetSectionOf[T]) truncate(asset *clients.Asset, n int) {
+	items := s.items(asset)
+	*items = (*items)[:n]
+}
+
+func (s assetSectionOf[T]) extend(asset *clients.Asset, page *clients.Asset) {
+	items := s.items(asset)
+	*items = append(*items, *s.items(page)...)
+}
+
+var assetSections = []assetSection{
+	assetSectionOf[clients.StringAttribute]{
+		section: clients.SectionStringAttributes,
+		items:   func(asset *clients.Asset) *[]clients.StringAttribute { return &asset.StringAttributes },
+		id:      func(item clients.StringAttribute) string { return item.ID },
+	},
+	assetSectionOf[clients.NumericAttribute]{
+		section: clients.SectionNumericAttributes,
+		items:   func(asset *clients.Asset) *[]clients.NumericAttribute { return &asset.NumericAttributes },
+		id:      func(item clients.NumericAttribute) string { return item.ID },
+	},
+	assetSectionOf[clients.BooleanAttribute]{
+		section: clients.SectionBooleanAttributes,
+		items:   func(asset *clients.Asset) *[]clients.BooleanAttribute { return &asset.BooleanAttributes },
+		id:      func(item clients.BooleanAttribute) string { return item.ID },
+	},
+	assetSectionOf[clients.DateAttribute]{
+		section: clients.SectionDateAttributes,
+		items:   func(asset *clients.Asset) *[]clients.DateAttribute { return &asset.DateAttributes },
+		id:      func(item clients.DateAttribute) string { return item.ID },
+	},
+	assetSectionOf[clients.OutgoingRelation]{
+		section: clients.SectionOutgoingRelations,
+		items:   func(asset *clients.Asset) *[]clients.OutgoingRelation { return &asset.OutgoingRelations },
+		id:      func(item clients.OutgoingRelation) string { return item.ID },
+	},
+	assetSectionOf[clients.IncomingRelation]{
+		section: clients.SectionIncomingRelations,
+		items:   func(asset *clients.Asset) *[]clients.IncomingRelation { return &asset.IncomingRelations },
+		id:      func(item clients.IncomingRelation) string { return item.ID },
+	},
+}
+
+func findAssetSection(name string) assetSection {
+	for _, section := range assetSections {
+		if section.name() == name {
+			return section
+		}
+	}
+	return nil
+}
+
+// pageSize returns the number of items of the section fetched per request.
+func pageSize(selection clients.AssetDetailsSelection, section string) int {
+	switch section {
+	case clients.SectionOutgoingRelations:
+		return limitOrDefault(selection.OutgoingRelationsLimit, clients.RelationsLimit)
+	case clients.SectionIncomingRelations:
+		return limitOrDefault(selection.IncomingRelationsLimit, clients.RelationsLimit)
+	default:
+		return limitOrDefault(selection.AttributesLimit, clients.AttributesLimit)
+	}
+}
+
+func limitOrDefault(limit int, defaultLimit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return defaultLimit
+}
+
+// withLargestPages uses the page size for the sections without an explicit limit, to need as few requests as possible.
+func withLargestPages(selection clients.AssetDetailsSelection, size int) clients.AssetDetailsSelection {
+	selection.AttributesLimit = limitOrDefault(selection.AttributesLimit, size)
+	selection.OutgoingRelationsLimit = limitOrDefault(selection.OutgoingRelationsLimit, size)
+	selection.IncomingRelationsLimit = limitOrDefault(selection.IncomingRelationsLimit, size)
+	return selection
+}
+
+// withLookahead asks for one item more than the page size of each section, to know whether a section has more items.
+// Pages of maxAssetDetailsLimit items cannot have a lookahead, see trimAssetSections.
+func withLookahead(request clients.AssetDetailsRequest) clients.AssetDetailsRequest {
+	selection := request.Selection
+	selection.AttributesLimit = lookaheadLimit(pageSize(selection, clients.SectionStringAttributes))
+	selection.OutgoingRelationsLimit = lookaheadLimit(pageSize(selection, clients.SectionOutgoingRelations))
+	selection.IncomingRelationsLimit = lookaheadLimit(pageSize(selection, clients.SectionIncomingRelations))
+	request.Selection = selection
+	return request
+}
+
+func lookaheadLimit(size int) int {
+	return min(size+1, maxAssetDetailsLimit)
+}
+
+// trimAssetSections cuts the sections of an asset fetched with lookahead back to their page size and reports per section
+// whether there are more items. A full page fetched without lookahead is assumed to have more items, which the next
+// page, empty if it did not, settles.
+func trimAssetSections(asset *clients.Asset, request clients.AssetDetailsRequest) []AssetDetailsPage {
+	var pages []AssetDetailsPage
+	for _, section := range assetSections {
+		if !request.Selection.Includes(section.name()) {
+			continue
+		}
+		size := pageSize(request.Selection, section.name())
+		page := AssetDetailsPage{Section: section.name(), Returned: section.length(asset)}
+		switch {
+		case page.Returned > size:
+			section.truncate(asset, size)
+			page.Returned = size
+			page.HasMore = true
+		case page.Returned == size && lookaheadLimit(size) == size:
+			page.HasMore = true
+		}
+		if page.HasMore {
+			page.NextCursor = section.lastID(asset)
+		}
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// fetchAllAssetSections keeps fetching the next page of every section that has more items, in one request per round,
+// until the sections are complete or hold maxItems items.
+func fetchAllAssetSections(ctx context.Context, collibraClient *http.Client, asset *clients.Asset, pages []AssetDetailsPage, request clients.AssetDetailsRequest, maxItems int) ([]AssetDetailsPage, error) {
+	for {
+		next := request
+		next.Selection.Sections = nil
+		next.Cursors = map[string]string{}
+		for _, page := range pages {
+			if page.HasMore && page.Returned < maxItems {
+				next.Selection.Sections = append(next.Selection.Sections, page.Section)
+				next.Cursors[page.Section] = page.NextCursor
+			}
+		}
+		if len(next.Selection.Sections) == 0 {
+			break
+		}
+
+		assets, err := clients.GetAssetSummary(ctx, collibraClient, withLookahead(next))
+		if err != nil {
+			return pages, err
+		}
+		if len(assets) == 0 {
+			break
+		}
+
+		nextPages := trimAssetSections(&assets[0], next)
+		for _, nextPage := range nextPages {
+			i := slices.IndexFunc(pages, func(page AssetDetailsPage) bool { return page.Section == nextPage.Section })
+			findAssetSection(nextPage.Section).extend(asset, &assets[0])
+			pages[i].Returned += nextPage.Returned
+			pages[i].HasMore = nextPage.HasMore
+			pages[i].NextCursor = nextPage.NextCursor
+		}
+	}
+
+	for i, page := range pages {
+		if page.Returned <= maxItems {
+			continue
+		}
+		section := findAssetSection(page.Section)
+		section.truncate(asset, maxItems)
+		pages[i].Returned = maxItems
+		pages[i].HasMore = true
+		pages[i].NextCursor = section.lastID(asset)
+	}
+	return pages, nil
+}
@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type AssignAssetResponsibilityInput struct {
+	AssetID   string `json:"assetId" jsonschema:"Required. The UUID of the asset to assign the responsibility on."`
+	Role      string `json:"role" jsonschema:"Required. The role to assign, given as a name (e.g., 'Steward') or UUID."`
+	User      string `json:"user,omitempty" jsonschema:"The user to assign the role to, given as a user name, email address, full name or UUID. Provide either user or userGroup."`
+	UserGroup string `json:"userGroup,omitempty" jsonschema:"The user group to assign the role to, given as a name or UUID. Provide either user or userGroup."`
+}
+
+type AssignAssetResponsibilityOutput struct {
+	Responsibility *clients.Responsibility `json:"responsibility,omitempty" jsonschema:"The created responsibility"`
+	Success        bool                    `json:"success" jsonschema:"Whether the responsibility was successfully assigned"`
+	Error          string                  `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+}
+
+func NewAssignAssetResponsibilityTool(collibraClient *http.Client) *chip.Tool[AssignAssetResponsibilityInput, AssignAssetResponsibilityOutput] {
+	return &chip.Tool[AssignAssetResponsibilityInput, AssignAssetResponsibilityOutput]{
+		Name:        "asset_responsibility_assign",
+		Description: "Assign a role (e.g., Owner, Steward) on an asset to a user or user group. Roles, users and groups can be given by name or UUID. Collibra only accepts the assignment if the current user is allowed to manage responsibilities on the asset.",
+		Handler:     handleAssignAssetResponsibility(collibraClient, clients.NewUserCache(collibraClient)),
+	}
+}
+
+func handleAssignAssetResponsibility(collibraClient *http.Client, userCache *clients.UserCache) chip.ToolHandlerFunc[AssignAssetResponsibilityInput, AssignAssetResponsibilityOutput] {
+	return func(ctx context.Context, input AssignAssetResponsibilityInput) (AssignAssetResponsibilityOutput, error) {
+		output, isNotValid := validateAssignResponsibilityInput(input)
+		if isNotValid {
+			return output, nil
+		}
+
+		roleIDs, err := resolveNames("role", []string{input.Role}, func(value string) ([]namedResource, error) {
+			roles, err := clients.FindRoles(ctx, collibraClient, value)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, len(roles.Results))
+			for i, role := range roles.Results {
+				resources[i] = namedResource{ID: role.ID, Name: role.Name}
+			}
+			return resources, nil
+		})
+		if err != nil {
+			return AssignAssetResponsibilityOutput{Success: false, Error: err.Error()}, nil
+		}
+
+		var ownerIDs []string
+		if strings.TrimSpace(input.User) != "" {
+			ownerIDs, err = resolveUserNames(ctx, userCache, []string{input.User})
+		} else {
+			ownerIDs, err = resolveNames("user group", []string{input.UserGroup}, func(value string) ([]namedResource, error) {
+				userGroups, err := clients.FindUserGroups(ctx, collibraClient, value)
+				if err != nil {
+					return nil, err
+				}
+				resources := make([]namedResource, len(userGroups.Results))
+				for i, userGroup := range userGroups.Results {
+					resources[i] = namedResource{ID: userGroup.ID, Name: userGroup.Name}
+				}
+				return resources, nil
+			})
+		}
+		if err != nil {
+			return AssignAssetResponsibilityOutput{Success: false, Error: err.Error()}, nil
+		}
+
+		responsibility, err := clients.AddResponsibility(ctx, collibraClient, clients.AddResponsibilityRequest{
+			RoleID:       roleIDs[0],
+			ResourceID:   input.AssetID,
+			ResourceType: "Asset",
+			OwnerID:      ownerIDs[0],
+		})
+		if err != nil {
+			return AssignAssetResponsibilityOutput{
+				Success: false,
+				Error:   fmt.Sprintf("Failed to assign responsibility: %s", err.Error()),
+			}, nil
+		}
+
+		return AssignAssetResponsibilityOutput{
+			Responsibility: responsibility,
+			Success:        true,
+		}, nil
+	}
+}
+
+func validateAssignResponsibilityInput(input AssignAssetResponsibilityInput) (AssignAssetResponsibilityOutput, bool) {
+	if _, err := uuid.Parse(input.AssetID); err != nil {
+		return AssignAssetResponsibilityOutput{
+			Success: false,
+			Error:   fmt.Sprintf("Invalid asset ID format: %s", err.Error()),
+		}, true
+	}
+
+	if strings.TrimSpace(input.Role) == "" {
+		return AssignAssetResponsibilityOutput{
+			Success: false,
+			Error:   "Role is required",
+		}, true
+	}
+
+	hasUser := strings.TrimSpace(input.User) != ""
+	hasUserGroup := strings.TrimSpace(input.UserGroup) != ""
+	if hasUser == hasUserGroup {
+		return AssignAssetResponsibilityOutput{
+			Success: false,
+			Error:   "Exactly one of user or userGroup is required",
+		}, true
+	}
+
+	return AssignAssetResponsibilityOutput{}, false
+}
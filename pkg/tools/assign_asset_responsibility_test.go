@@ -0,0 +1,137 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestAssignAssetResponsibility(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/roles", JsonHandlerOut(func(r *http.Request) (int, clients.RolePagedResponse) {
+		return http.StatusOK, clients.RolePagedResponse{
+			Results: []clients.Role{
+				{ID: "00000000-0000-0000-0000-000000005016", Name: "Steward"},
+				{ID: "00000000-0000-0000-0000-000000005017", Name: "Steward Assistant"},
+			},
+		}
+	}))
+	handler.Handle("/rest/2.0/users", JsonHandlerOut(func(r *http.Request) (int, clients.UserPagedResponse) {
+		return http.StatusOK, clients.UserPagedResponse{
+			Results: []clients.UserDetails{{ID: "00000000-0000-0000-0000-0000000000a1", UserName: "jdoe", EmailAddress: "jdoe@example.com"}},
+		}
+	}))
+	handler.Handle("POST /rest/2.0/responsibilities", JsonHandlerInOut(func(r *http.Request, request clients.AddResponsibilityRequest) (int, clients.Responsibility) {
+		if request.RoleID != "00000000-0000-0000-0000-000000005016" || request.OwnerID != "00000000-0000-0000-0000-0000000000a1" || request.ResourceID != assetId || request.ResourceType != "Asset" {
+			t.Errorf("Unexpected responsibility request: %+v", request)
+		}
+		return http.StatusCreated, clients.Responsibility{
+			ID:           "00000000-0000-0000-0000-0000000000r1",
+			Role:         clients.NamedResourceReference{ID: request.RoleID, Name: "Steward"},
+			Owner:        clients.NamedResourceReference{ID: request.OwnerID, ResourceType: "User"},
+			BaseResource: clients.NamedResourceReference{ID: request.ResourceID, ResourceType: "Asset"},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewAssignAssetResponsibilityTool(client).Handler(t.Context(), tools.AssignAssetResponsibilityInput{
+		AssetID: assetId,
+		Role:    "steward",
+		User:    "jdoe@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected success, got error: %s", output.Error)
+	}
+	if output.Responsibility == nil || output.Responsibility.Role.Name != "Steward" {
+		t.Fatalf("Expected the created responsibility to be returned, got: %+v", output.Responsibility)
+	}
+}
+
+func TestAssignAssetResponsibility_RequiresSingleOwner(t *testing.T) {
+	output, err := tools.NewAssignAssetResponsibilityTool(&http.Client{}).Handler(t.Context(), tools.AssignAssetResponsibilityInput{
+		AssetID:   "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8",
+		Role:      "Steward",
+		User:      "jdoe",
+		UserGroup: "Data Stewards",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || !strings.Contains(output.Error, "Exactly one of user or userGroup") {
+		t.Fatalf("Expected a validation error, got: %+v", output)
+	}
+}
+
+func TestAssignAssetResponsibility_UnknownRole(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/roles", JsonHandlerOut(func(r *http.Request) (int, clients.RolePagedResponse) {
+		return http.StatusOK, clients.RolePagedResponse{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAssignAssetResponsibilityTool(newClient(server)).Handler(t.Context(), tools.AssignAssetResponsibilityInput{
+		AssetID:   "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8",
+		Role:      "Custodian",
+		UserGroup: "Data Stewards",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || !strings.Contains(output.Error, "unknown role 'Custodian'") {
+		t.Fatalf("Expected an unknown role error, got: %+v", output)
+	}
+}
+
+func TestAssignAssetResponsibility_UserCreatedLater(t *testing.T) {
+	userCreated := false
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/roles", JsonHandlerOut(func(r *http.Request) (int, clients.RolePagedResponse) {
+		return http.StatusOK, clients.RolePagedResponse{Results: []clients.Role{{ID: "00000000-0000-0000-0000-000000005016", Name: "Steward"}}}
+	}))
+	handler.Handle("/rest/2.0/users", JsonHandlerOut(func(r *http.Request) (int, clients.UserPagedResponse) {
+		if !userCreated {
+			return http.StatusOK, clients.UserPagedResponse{}
+		}
+		return http.StatusOK, clients.UserPagedResponse{
+			Results: []clients.UserDetails{{ID: "00000000-0000-0000-0000-0000000000a1", UserName: "jdoe"}},
+		}
+	}))
+	handler.Handle("POST /rest/2.0/responsibilities", JsonHandlerInOut(func(r *http.Request, request clients.AddResponsibilityRequest) (int, clients.Responsibility) {
+		return http.StatusCreated, clients.Responsibility{ID: "00000000-0000-0000-0000-0000000000r1"}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewAssignAssetResponsibilityTool(newClient(server))
+	input := tools.AssignAssetResponsibilityInput{AssetID: "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8", Role: "Steward", User: "jdoe"}
+	output, err := tool.Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success {
+		t.Fatalf("Expected an unknown user to fail, got: %+v", output)
+	}
+
+	userCreated = true
+	output, err = tool.Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected the user created meanwhile to be found, got error: %s", output.Error)
+	}
+}
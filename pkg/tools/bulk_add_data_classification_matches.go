@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+type BulkAddDataClassificationMatchesInput struct {
+	Matches      []AddDataClassificationMatchInput `json:"matches" jsonschema:"Required. The asset and data class pairs to classify, at most 500."`
+	AllOrNothing bool                              `json:"allOrNothing,omitempty" jsonschema:"Optional. When true and any pair fails, the matches added by this call are removed again, so either all pairs are classified or none is. Default: false."`
+}
+
+func NewBulkAddDataClassificationMatchesTool(collibraClient *http.Client) *chip.Tool[BulkAddDataClassificationMatchesInput, BulkClassificationMatchesOutput] {
+	return &chip.Tool[BulkAddDataClassificationMatchesInput, BulkClassificationMatchesOutput]{
+		Name:        "data_classification_match_bulk_add",
+		Description: "Associate data classes with many assets in one call, e.g. to classify all columns of a table. Takes a list of asset and data class UUID pairs and reports the outcome per pair. Pairs that are already classified are skipped, so the call can safely be repeated. With allOrNothing, the matches added are removed again when any pair fails.",
+		Handler:     handleBulkAddDataClassificationMatches(collibraClient),
+	}
+}
+
+func handleBulkAddDataClassificationMatches(collibraClient *http.Client) chip.ToolHandlerFunc[BulkAddDataClassificationMatchesInput, BulkClassificationMatchesOutput] {
+	return func(ctx context.Context, input BulkAddDataClassificationMatchesInput) (BulkClassificationMatchesOutput, error) {
+		if len(input.Matches) == 0 {
+			return BulkClassificationMatchesOutput{Success: false, Error: "At least one asset and data class pair is required"}, nil
+		}
+		if len(input.Matches) > classificationMatchBulkLimit {
+			return BulkClassificationMatchesOutput{Success: false, Error: fmt.Sprintf("At most %d pairs can be classified at once", classificationMatchBulkLimit)}, nil
+		}
+
+		// Pairs given more than once are only added once.
+		output := BulkClassificationMatchesOutput{}
+		seen := map[string]bool{}
+		for _, match := range input.Matches {
+			result := BulkClassificationMatchResult{AssetID: strings.TrimSpace(match.AssetID), ClassificationID: strings.TrimSpace(match.ClassificationID)}
+			if key := result.AssetID + "/" + result.ClassificationID; !seen[key] {
+				seen[key] = true
+				output.Results = append(output.Results, result)
+			}
+		}
+
+		if err := forEachConcurrently(ctx, len(output.Results), func(i int) {
+			addClassificationMatch(ctx, collibraClient, &output.Results[i])
+		}); err != nil {
+			failUnprocessed(output.Results, err)
+		}
+		countBulkResults(&output, "added", "exists")
+
+		if input.AllOrNothing && output.Failed > 0 && output.Changed > 0 {
+			err := forEachConcurrently(ctx, len(output.Results), func(i int) {
+				result := &output.Results[i]
+				if result.Status != "added" {
+					return
+				}
+				if err := clients.RemoveDataClassificationMatch(ctx, collibraClient, result.ClassificationMatchID); err != nil && !errors.Is(err, clients.ErrClassificationMatchNotFound) {
+					result.Error = fmt.Sprintf("Failed to roll back: %s", err.Error())
+					return
+				}
+				result.Status = "rolled_back"
+			})
+			countBulkResults(&output, "added", "exists")
+			if notRolledBack := countNotRolledBack(output.Results, "added", err); notRolledBack > 0 {
+				output.Error = fmt.Sprintf("Failed to roll back %d of the added matches, they are still there", notRolledBack)
+			} else {
+				output.RolledBack = true
+			}
+			output.Success = false
+		}
+		return output, nil
+	}
+}
+
+func addClassificationMatch(ctx context.Context, collibraClient *http.Client, result *BulkClassificationMatchResult) {
+	if result.AssetID == "" || result.ClassificationID == "" {
+		result.Status, result.Error = "failed", "Asset ID and classification ID are required"
+		return
+	}
+
+	match, err := clients.AddDataClassificationMatch(ctx, collibraClient, clients.AddDataClassificationMatchRequest{
+		AssetID:          result.AssetID,
+		ClassificationID: result.ClassificationID,
+	})
+	switch {
+	case errors.Is(err, clients.ErrClassificationMatchExists):
+		result.Status = "exists"
+		existing, _, err := clients.SearchDataClassificationMatches(ctx, collibraClient, clients.DataClassificationMatchQueryParams{
+			AssetIDs:          []string{result.AssetID},
+			ClassificationIDs: []string{result.ClassificationID},
+		})
+		if err != nil {
+			slog.WarnContext(ctx, fmt.Sprintf("Failed to look up the existing classification match: %v", err))
+		} else if len(existing) > 0 {
+			result.ClassificationMatchID = existing[0].ID
+		}
+	case err != nil:
+		result.Status, result.Error = "failed", fmt.Sprintf("Failed to add classification match: %s", err.Error())
+	default:
+		result.Status, result.ClassificationMatchID = "added", match.ID
+	}
+}
@@ -0,0 +1,139 @@
+package tools_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/tools"
+)
+
+const emailClassID = "cccccccc-0000-0000-0000-000000000001"
+
+func columnIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("aaaaaaaa-0000-0000-0000-%012d", i)
+	}
+	return ids
+}
+
+func TestBulkAddDataClassificationMatches(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	columns := columnIDs(40)
+	existingID := fake.add(columns[0], emailClassID)
+	server := fake.server()
+	defer server.Close()
+
+	input := tools.BulkAddDataClassificationMatchesInput{}
+	for _, column := range columns {
+		input.Matches = append(input.Matches, tools.AddDataClassificationMatchInput{AssetID: column, ClassificationID: emailClassID})
+	}
+	input.Matches = append(input.Matches, input.Matches[1])
+
+	output, err := tools.NewBulkAddDataClassificationMatchesTool(newClient(server)).Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Changed != 39 || output.Skipped != 1 || len(output.Results) != 40 {
+		t.Fatalf("Expected 39 pairs added and the existing one skipped, got: %+v", output)
+	}
+	if output.Results[0].Status != "exists" || output.Results[0].ClassificationMatchID != existingID {
+		t.Errorf("Expected the existing match to be reported, got: %+v", output.Results[0])
+	}
+	if output.Results[1].Status != "added" || output.Results[1].ClassificationMatchID == "" {
+		t.Errorf("Expected the match to be added, got: %+v", output.Results[1])
+	}
+	if maxInFlight := fake.maxInFlight.Load(); maxInFlight > 8 {
+		t.Errorf("Expected at most 8 requests at once, got %d", maxInFlight)
+	}
+}
+
+func TestBulkAddDataClassificationMatches_AllOrNothing(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	columns := columnIDs(5)
+	fake.failingAssetID = columns[3]
+	server := fake.server()
+	defer server.Close()
+
+	input := tools.BulkAddDataClassificationMatchesInput{AllOrNothing: true}
+	for _, column := range columns {
+		input.Matches = append(input.Matches, tools.AddDataClassificationMatchInput{AssetID: column, ClassificationID: emailClassID})
+	}
+
+	output, err := tools.NewBulkAddDataClassificationMatchesTool(newClient(server)).Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || !output.RolledBack || output.Failed != 1 || output.Changed != 0 {
+		t.Fatalf("Expected the call to be rolled back, got: %+v", output)
+	}
+	for i, result := range output.Results {
+		expected := "rolled_back"
+		if i == 3 {
+			expected = "failed"
+		}
+		if result.Status != expected {
+			t.Errorf("Expected pair %d to be %s, got: %+v", i, expected, result)
+		}
+	}
+	if len(fake.matches) != 0 {
+		t.Errorf("Expected no match to be left, got: %+v", fake.matches)
+	}
+}
+
+func TestBulkAddDataClassificationMatches_AllOrNothingRollbackFails(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	columns := columnIDs(5)
+	fake.failingAssetID = columns[3]
+	// The first match added cannot be removed again.
+	fake.failingMatchID = "bbbbbbbb-0000-0000-0000-000000000001"
+	server := fake.server()
+	defer server.Close()
+
+	input := tools.BulkAddDataClassificationMatchesInput{AllOrNothing: true}
+	for _, column := range columns {
+		input.Matches = append(input.Matches, tools.AddDataClassificationMatchInput{AssetID: column, ClassificationID: emailClassID})
+	}
+
+	output, err := tools.NewBulkAddDataClassificationMatchesTool(newClient(server)).Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || output.RolledBack || output.Changed != 1 || !strings.Contains(output.Error, "Failed to roll back 1 of the added matches") {
+		t.Fatalf("Expected the failed rollback to be reported, got: %+v", output)
+	}
+	for _, result := range output.Results {
+		if result.ClassificationMatchID == fake.failingMatchID && (result.Status != "added" || !strings.HasPrefix(result.Error, "Failed to roll back")) {
+			t.Errorf("Expected the match that could not be removed to be reported, got: %+v", result)
+		}
+	}
+	if len(fake.matches) != 1 {
+		t.Errorf("Expected the match that could not be removed to be left, got: %+v", fake.matches)
+	}
+}
+
+func TestBulkAddDataClassificationMatches_Canceled(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	server := fake.server()
+	defer server.Close()
+
+	input := tools.BulkAddDataClassificationMatchesInput{}
+	for _, column := range columnIDs(20) {
+		input.Matches = append(input.Matches, tools.AddDataClassificationMatchInput{AssetID: column, ClassificationID: emailClassID})
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	output, err := tools.NewBulkAddDataClassificationMatchesTool(newClient(server)).Handler(ctx, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || output.Failed != 20 || !strings.HasPrefix(output.Results[0].Error, "Not processed") {
+		t.Fatalf("Expected no pair to be processed, got: %+v", output)
+	}
+	if len(fake.matches) != 0 {
+		t.Errorf("Expected no match to be added, got: %+v", fake.matches)
+	}
+}
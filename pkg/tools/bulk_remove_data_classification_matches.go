@@ -0,0 +1,146 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+type BulkRemoveDataClassificationMatchesInput struct {
+	ClassificationMatchIDs []string `json:"classificationMatchIds" jsonschema:"Required. The UUIDs of the classification matches to remove, at most 500."`
+	AllOrNothing           bool     `json:"allOrNothing,omitempty" jsonschema:"Optional. When true and any match fails to be removed, the matches removed by this call are added again, so either all matches are removed or none is. Matches added again get a new UUID and their accepted or rejected status back; they are reported as recreated rather than restored when their status or confidence could not be restored, as for suggested matches. Default: false."`
+}
+
+func NewBulkRemoveDataClassificationMatchesTool(collibraClient *http.Client) *chip.Tool[BulkRemoveDataClassificationMatchesInput, BulkClassificationMatchesOutput] {
+	return &chip.Tool[BulkRemoveDataClassificationMatchesInput, BulkClassificationMatchesOutput]{
+		Name:        "data_classification_match_bulk_remove",
+		Description: "Remove many classification matches in one call. Takes a list of classification match UUIDs and reports the outcome per match. Matches that no longer exist are skipped, so the call can safely be repeated. With allOrNothing, the matches removed are added again when any removal fails; suggested matches come back without their original status and confidence.",
+		Handler:     handleBulkRemoveDataClassificationMatches(collibraClient),
+	}
+}
+
+func handleBulkRemoveDataClassificationMatches(collibraClient *http.Client) chip.ToolHandlerFunc[BulkRemoveDataClassificationMatchesInput, BulkClassificationMatchesOutput] {
+	return func(ctx context.Context, input BulkRemoveDataClassificationMatchesInput) (BulkClassificationMatchesOutput, error) {
+		if len(input.ClassificationMatchIDs) == 0 {
+			return BulkClassificationMatchesOutput{Success: false, Error: "At least one classification match ID is required"}, nil
+		}
+		if len(input.ClassificationMatchIDs) > classificationMatchBulkLimit {
+			return BulkClassificationMatchesOutput{Success: false, Error: fmt.Sprintf("At most %d classification matches can be removed at once", classificationMatchBulkLimit)}, nil
+		}
+
+		output := BulkClassificationMatchesOutput{}
+		seen := map[string]bool{}
+		for _, id := range input.ClassificationMatchIDs {
+			if id = strings.TrimSpace(id); !seen[id] {
+				seen[id] = true
+				output.Results = append(output.Results, BulkClassificationMatchResult{ClassificationMatchID: id})
+			}
+		}
+
+		originals := make([]*clients.DataClassificationMatch, len(output.Results))
+		if err := forEachConcurrently(ctx, len(output.Results), func(i int) {
+			originals[i] = removeClassificationMatch(ctx, collibraClient, &output.Results[i], input.AllOrNothing)
+		}); err != nil {
+			failUnprocessed(output.Results, err)
+		}
+		countBulkResults(&output, "removed", "not_found")
+
+		if input.AllOrNothing && output.Failed > 0 && output.Changed > 0 {
+			err := forEachConcurrently(ctx, len(output.Results), func(i int) {
+				result := &output.Results[i]
+				if result.Status != "removed" {
+					return
+				}
+				restoreClassificationMatch(ctx, collibraClient, result, originals[i])
+			})
+			countBulkResults(&output, "removed", "not_found")
+			if notRestored := countNotRolledBack(output.Results, "removed", err); notRestored > 0 {
+				output.Error = fmt.Sprintf("Failed to restore %d of the removed matches, they are still removed", notRestored)
+			} else {
+				output.RolledBack = true
+			}
+			output.Success = false
+		}
+		return output, nil
+	}
+}
+
+// removeClassificationMatch removes the match. To be able to restore it, it is first retrieved when restorable is set,
+// and returned.
+func removeClassificationMatch(ctx context.Context, collibraClient *http.Client, result *BulkClassificationMatchResult, restorable bool) *clients.DataClassificationMatch {
+	if result.ClassificationMatchID == "" {
+		result.Status, result.Error = "failed", "Classification match ID is required"
+		return nil
+	}
+
+	var original *clients.DataClassificationMatch
+	if restorable {
+		match, err := clients.GetDataClassificationMatch(ctx, collibraClient, result.ClassificationMatchID)
+		if errors.Is(err, clients.ErrClassificationMatchNotFound) {
+			result.Status = "not_found"
+			return nil
+		}
+		if err != nil {
+			result.Status, result.Error = "failed", fmt.Sprintf("Failed to retrieve classification match: %s", err.Error())
+			return nil
+		}
+		original = match
+		result.AssetID, result.ClassificationID = match.Asset.ID, match.Classification.ID
+	}
+
+	err := clients.RemoveDataClassificationMatch(ctx, collibraClient, result.ClassificationMatchID)
+	switch {
+	case errors.Is(err, clients.ErrClassificationMatchNotFound):
+		result.Status = "not_found"
+	case err != nil:
+		result.Status, result.Error = "failed", fmt.Sprintf("Failed to remove classification match: %s", err.Error())
+	default:
+		result.Status = "removed"
+	}
+	return original
+}
+
+// restoreClassificationMatch adds a removed match again and gives it back its accepted or rejected status. Matches that
+// do not end up with their original status and confidence, such as suggested ones, are reported as recreated.
+func restoreClassificationMatch(ctx context.Context, collibraClient *http.Client, result *BulkClassificationMatchResult, original *clients.DataClassificationMatch) {
+	match, err := clients.AddDataClassificationMatch(ctx, collibraClient, clients.AddDataClassificationMatchRequest{
+		AssetID:          original.Asset.ID,
+		ClassificationID: original.Classification.ID,
+	})
+	if errors.Is(err, clients.ErrClassificationMatchExists) {
+		result.Status = "recreated"
+		return
+	}
+	if err != nil {
+		result.Error = fmt.Sprintf("Failed to restore: %s", err.Error())
+		return
+	}
+	result.ClassificationMatchID = match.ID
+
+	if match.Status != original.Status {
+		var reviewed *clients.DataClassificationMatch
+		switch original.Status {
+		case "ACCEPTED":
+			reviewed, err = clients.AcceptDataClassificationMatch(ctx, collibraClient, match.ID)
+		case "REJECTED":
+			reviewed, err = clients.RejectDataClassificationMatch(ctx, collibraClient, match.ID)
+		}
+		if err != nil {
+			result.Status, result.Error = "recreated", fmt.Sprintf("Failed to restore the %s status: %s", original.Status, err.Error())
+			return
+		}
+		if reviewed != nil {
+			match = reviewed
+		}
+	}
+
+	result.Status = "restored"
+	if match.Status != original.Status || match.Confidence != original.Confidence {
+		result.Status = "recreated"
+	}
+}
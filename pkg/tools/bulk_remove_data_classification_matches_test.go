@@ -0,0 +1,74 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestBulkRemoveDataClassificationMatches(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	var ids []string
+	for _, column := range columnIDs(20) {
+		ids = append(ids, fake.add(column, emailClassID))
+	}
+	server := fake.server()
+	defer server.Close()
+
+	output, err := tools.NewBulkRemoveDataClassificationMatchesTool(newClient(server)).Handler(t.Context(), tools.BulkRemoveDataClassificationMatchesInput{
+		ClassificationMatchIDs: append(ids, "dddddddd-0000-0000-0000-000000000000"),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Changed != 20 || output.Skipped != 1 {
+		t.Fatalf("Expected 20 matches removed and the missing one skipped, got: %+v", output)
+	}
+	if output.Results[20].Status != "not_found" {
+		t.Errorf("Expected the missing match to be skipped, got: %+v", output.Results[20])
+	}
+	if len(fake.matches) != 0 {
+		t.Errorf("Expected all matches to be removed, got: %+v", fake.matches)
+	}
+}
+
+func TestBulkRemoveDataClassificationMatches_AllOrNothing(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	columns := columnIDs(3)
+	ids := []string{
+		fake.suggest(columns[0], emailClassID, 1),
+		fake.suggest(columns[1], emailClassID, 0.9),
+		fake.add(columns[2], emailClassID),
+	}
+	accepted := fake.matches[ids[0]]
+	accepted.Status = "ACCEPTED"
+	fake.matches[ids[0]] = accepted
+	fake.failingMatchID = ids[2]
+	server := fake.server()
+	defer server.Close()
+
+	output, err := tools.NewBulkRemoveDataClassificationMatchesTool(newClient(server)).Handler(t.Context(), tools.BulkRemoveDataClassificationMatchesInput{
+		ClassificationMatchIDs: ids,
+		AllOrNothing:           true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || !output.RolledBack || output.Failed != 1 {
+		t.Fatalf("Expected the call to be rolled back, got: %+v", output)
+	}
+	for _, column := range columns {
+		if _, ok := fake.find(column, emailClassID); !ok {
+			t.Errorf("Expected the match of %s to be restored", column)
+		}
+	}
+	if output.Results[0].Status != "restored" || output.Results[0].ClassificationMatchID == ids[0] {
+		t.Errorf("Expected the match to be restored under a new ID, got: %+v", output.Results[0])
+	}
+	if fake.status(output.Results[0].ClassificationMatchID) != "ACCEPTED" {
+		t.Errorf("Expected the restored match to be accepted again, got: %s", fake.status(output.Results[0].ClassificationMatchID))
+	}
+	if output.Results[1].Status != "recreated" {
+		t.Errorf("Expected the suggested match to be reported as recreated, as its confidence is lost, got: %+v", output.Results[1])
+	}
+}
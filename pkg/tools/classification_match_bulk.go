@@ -0,0 +1,97 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const (
+	// classificationMatchBulkLimit bounds the number of items of a bulk classification match call.
+	classificationMatchBulkLimit = 500
+	// classificationMatchConcurrency bounds the number of classification match requests sent at once.
+	classificationMatchConcurrency = 8
+)
+
+type BulkClassificationMatchResult struct {
+	ClassificationMatchID string `json:"classificationMatchId,omitempty" jsonschema:"The UUID of the classification match"`
+	AssetID               string `json:"assetId,omitempty" jsonschema:"The UUID of the classified asset"`
+	ClassificationID      string `json:"classificationId,omitempty" jsonschema:"The UUID of the data class"`
+	Status                string `json:"status" jsonschema:"What happened to the item: added, exists, removed, not_found, failed, rolled_back, restored, or recreated when added again without its original status or confidence"`
+	Error                 string `json:"error,omitempty" jsonschema:"Error message if the item failed"`
+}
+
+type BulkClassificationMatchesOutput struct {
+	Results    []BulkClassificationMatchResult `json:"results" jsonschema:"The outcome per item, in the order given"`
+	Changed    int                             `json:"changed" jsonschema:"The number of items changed and kept"`
+	Skipped    int                             `json:"skipped" jsonschema:"The number of items that were already as requested"`
+	Failed     int                             `json:"failed" jsonschema:"The number of items that failed"`
+	RolledBack bool                            `json:"rolledBack" jsonschema:"Whether all the changes were undone because an item failed in all-or-nothing mode. When some could not be undone, error tells how many and their results tell which."`
+	Success    bool                            `json:"success" jsonschema:"Whether no item failed"`
+	Error      string                          `json:"error,omitempty" jsonschema:"Error message if the operation failed as a whole"`
+}
+
+// forEachConcurrently calls fn for 0 to n-1, running at most classificationMatchConcurrency calls at once. Once ctx is
+// done no more calls are started: it waits for the running ones and returns the error of ctx.
+func forEachConcurrently(ctx context.Context, n int, fn func(i int)) error {
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, classificationMatchConcurrency)
+	for i := range n {
+		select {
+		case <-ctx.Done():
+		case slots <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			wg.Wait()
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// failUnprocessed marks the results that were not processed because the call was canceled as failed.
+func failUnprocessed(results []BulkClassificationMatchResult, err error) {
+	for i := range results {
+		if results[i].Status == "" {
+			results[i].Status, results[i].Error = "failed", fmt.Sprintf("Not processed: %s", err.Error())
+		}
+	}
+}
+
+// countNotRolledBack counts the results still in the changed status after a rollback, and gives the ones that were
+// not attempted because the call was canceled an error.
+func countNotRolledBack(results []BulkClassificationMatchResult, changed string, err error) int {
+	count := 0
+	for i := range results {
+		if results[i].Status != changed {
+			continue
+		}
+		count++
+		if results[i].Error == "" && err != nil {
+			results[i].Error = fmt.Sprintf("Not rolled back: %s", err.Error())
+		}
+	}
+	return count
+}
+
+func countBulkResults(output *BulkClassificationMatchesOutput, changed string, skipped string) {
+	output.Changed, output.Skipped, output.Failed = 0, 0, 0
+	for _, result := range output.Results {
+		switch result.Status {
+		case changed:
+			output.Changed++
+		case skipped:
+			output.Skipped++
+		case "failed":
+			output.Failed++
+		}
+	}
+	output.Success = output.Failed == 0
+}
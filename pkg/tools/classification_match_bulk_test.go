@@ -0,0 +1,161 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/collibra/chip/pkg/clients"
+)
+
+const classificationMatchesPath = "/rest/catalog/1.0/dataClassification/classificationMatches"
+
+// fakeClassificationMatches is an in-memory Collibra holding classification matches. Adding a match for failingAssetID
+// and removing failingMatchID fail.
+type fakeClassificationMatches struct {
+	mu             sync.Mutex
+	matches        map[string]clients.DataClassificationMatch
+	nextID         int
+	failingAssetID string
+	failingMatchID string
+	inFlight       atomic.Int32
+	maxInFlight    atomic.Int32
+}
+
+func newFakeClassificationMatches() *fakeClassificationMatches {
+	return &fakeClassificationMatches{matches: map[string]clients.DataClassificationMatch{}}
+}
+
+func (f *fakeClassificationMatches) add(assetID string, classificationID string) string {
+	return f.suggest(assetID, classificationID, 1)
+}
+
+func (f *fakeClassificationMatches) suggest(assetID string, classificationID string, confidence float64) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	id := fmt.Sprintf("bbbbbbbb-0000-0000-0000-%012d", f.nextID)
+	f.matches[id] = clients.DataClassificationMatch{
+		ID:             id,
+		Status:         "SUGGESTED",
+		Confidence:     confidence,
+		Asset:          clients.NamedResourceReference{ID: assetID},
+		Classification: clients.DataClassification{ID: classificationID},
+	}
+	return id
+}
+
+func (f *fakeClassificationMatches) status(id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.matches[id].Status
+}
+
+func (f *fakeClassificationMatches) find(assetID string, classificationID string) (clients.DataClassificationMatch, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, match := range f.matches {
+		if match.Asset.ID == assetID && match.Classification.ID == classificationID {
+			return match, true
+		}
+	}
+	return clients.DataClassificationMatch{}, false
+}
+
+// track records how many requests are handled at once.
+func (f *fakeClassificationMatches) track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight := f.inFlight.Add(1)
+		defer f.inFlight.Add(-1)
+		for {
+			current := f.maxInFlight.Load()
+			if inFlight <= current || f.maxInFlight.CompareAndSwap(current, inFlight) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (f *fakeClassificationMatches) server() *httptest.Server {
+	return httptest.NewServer(f.handler())
+}
+
+func (f *fakeClassificationMatches) handler() *http.ServeMux {
+	handler := http.NewServeMux()
+	handler.Handle("POST "+classificationMatchesPath, f.track(JsonHandlerInOut(func(_ *http.Request, request clients.AddDataClassificationMatchRequest) (int, any) {
+		if request.AssetID == f.failingAssetID {
+			return http.StatusInternalServerError, map[string]string{"message": "internal error"}
+		}
+		if _, exists := f.find(request.AssetID, request.ClassificationID); exists {
+			return http.StatusUnprocessableEntity, map[string]string{"message": "already exists"}
+		}
+		id := f.add(request.AssetID, request.ClassificationID)
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		return http.StatusCreated, f.matches[id]
+	})))
+	handler.Handle("GET "+classificationMatchesPath+"/bulk", f.track(JsonHandlerOut(func(r *http.Request) (int, clients.PagedResponseDataClassificationMatch) {
+		query := r.URL.Query()
+		response := clients.PagedResponseDataClassificationMatch{Results: []clients.DataClassificationMatch{}}
+		if query.Has("assetIds") {
+			if match, ok := f.find(query.Get("assetIds"), query.Get("classificationIds")); ok {
+				response.Results = append(response.Results, match)
+			}
+		} else {
+			f.mu.Lock()
+			for _, match := range f.matches {
+				if (!query.Has("statuses") || query.Get("statuses") == match.Status) &&
+					(!query.Has("classificationIds") || query.Get("classificationIds") == match.Classification.ID) {
+					response.Results = append(response.Results, match)
+				}
+			}
+			f.mu.Unlock()
+			slices.SortFunc(response.Results, func(a, b clients.DataClassificationMatch) int { return strings.Compare(a.ID, b.ID) })
+		}
+		response.Total = int64(len(response.Results))
+		return http.StatusOK, response
+	})))
+	handler.Handle("GET "+classificationMatchesPath+"/{id}", f.track(JsonHandlerOut(func(r *http.Request) (int, any) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		match, ok := f.matches[r.PathValue("id")]
+		if !ok {
+			return http.StatusNotFound, map[string]string{"message": "not found"}
+		}
+		return http.StatusOK, match
+	})))
+	handler.Handle("POST "+classificationMatchesPath+"/{id}/{decision}", f.track(JsonHandlerOut(func(r *http.Request) (int, any) {
+		if r.PathValue("id") == f.failingMatchID {
+			return http.StatusInternalServerError, map[string]string{"message": "internal error"}
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		match, ok := f.matches[r.PathValue("id")]
+		if !ok {
+			return http.StatusNotFound, map[string]string{"message": "not found"}
+		}
+		match.Status = map[string]string{"accept": "ACCEPTED", "reject": "REJECTED"}[r.PathValue("decision")]
+		f.matches[match.ID] = match
+		return http.StatusOK, match
+	})))
+	handler.Handle("DELETE "+classificationMatchesPath+"/{id}", f.track(StringHandlerOut(func(r *http.Request) (int, string) {
+		if r.PathValue("id") == f.failingMatchID {
+			return http.StatusInternalServerError, "internal error"
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.matches[r.PathValue("id")]; !ok {
+			return http.StatusNotFound, ""
+		}
+		delete(f.matches, r.PathValue("id"))
+		return http.StatusNoContent, ""
+	})))
+	return handler
+}
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+type CompleteWorkflowTaskInput struct {
+	TaskID         string            `json:"taskId" jsonschema:"Required. The ID of the task to complete, as returned by workflow_tasks_list."`
+	FormProperties map[string]string `json:"formProperties,omitempty" jsonschema:"Optional. The values of the task form, keyed by form property ID (e.g., an approve/reject decision). Enumeration values can be given by ID or name."`
+}
+
+type CompleteWorkflowTaskOutput struct {
+	Success          bool                   `json:"success" jsonschema:"Whether the task was successfully completed"`
+	Error            string                 `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+	ValidationErrors []string               `json:"validationErrors,omitempty" jsonschema:"The problems found in the provided form properties"`
+	FormProperties   []WorkflowFormProperty `json:"formProperties,omitempty" jsonschema:"The form properties of the task, returned when the provided values are invalid"`
+}
+
+func NewCompleteWorkflowTaskTool(collibraClient *http.Client) *chip.Tool[CompleteWorkflowTaskInput, CompleteWorkflowTaskOutput] {
+	return &chip.Tool[CompleteWorkflowTaskInput, CompleteWorkflowTaskOutput]{
+		Name:        "workflow_task_complete",
+		Description: "Complete a workflow task from the current user's Collibra task inbox. The form properties are validated against the task form first; when invalid, the expected form properties are returned.",
+		Handler:     handleCompleteWorkflowTask(collibraClient),
+	}
+}
+
+func handleCompleteWorkflowTask(collibraClient *http.Client) chip.ToolHandlerFunc[CompleteWorkflowTaskInput, CompleteWorkflowTaskOutput] {
+	return func(ctx context.Context, input CompleteWorkflowTaskInput) (CompleteWorkflowTaskOutput, error) {
+		if strings.TrimSpace(input.TaskID) == "" {
+			return CompleteWorkflowTaskOutput{Success: false, Error: "Task ID is required"}, nil
+		}
+
+		formData, err := clients.GetWorkflowTaskFormData(ctx, collibraClient, input.TaskID)
+		if err != nil {
+			return CompleteWorkflowTaskOutput{Success: false, Error: fmt.Sprintf("Failed to retrieve task form: %s", err.Error())}, nil
+		}
+
+		formProperties, validationErrors := validateFormProperties(formData, input.FormProperties)
+		if len(validationErrors) > 0 {
+			return CompleteWorkflowTaskOutput{
+				Success:          false,
+				Error:            "The form properties are invalid",
+				ValidationErrors: validationErrors,
+				FormProperties:   describeFormProperties(formData),
+			}, nil
+		}
+
+		err = clients.CompleteWorkflowTasks(ctx, collibraClient, clients.CompleteWorkflowTasksRequest{
+			TaskIDs:            []string{input.TaskID},
+			TaskFormProperties: formProperties,
+		})
+		if err != nil {
+			return CompleteWorkflowTaskOutput{Success: false, Error: fmt.Sprintf("Failed to complete task: %s", err.Error())}, nil
+		}
+
+		return CompleteWorkflowTaskOutput{Success: true}, nil
+	}
+}
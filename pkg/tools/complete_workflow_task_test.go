@@ -0,0 +1,56 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestCompleteWorkflowTask(t *testing.T) {
+	var completed clients.CompleteWorkflowTasksRequest
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/workflowTasks/task-1/taskFormData", JsonHandlerOut(func(r *http.Request) (int, clients.FormData) {
+		return http.StatusOK, clients.FormData{
+			FormProperties: []clients.FormProperty{
+				{ID: "approved", Name: "Approve", Type: "boolean", Required: true, Writable: true},
+			},
+		}
+	}))
+	handler.Handle("POST /rest/2.0/workflowTasks/completed", JsonHandlerInOut(func(r *http.Request, request clients.CompleteWorkflowTasksRequest) (int, map[string]any) {
+		completed = request
+		return http.StatusOK, map[string]any{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewCompleteWorkflowTaskTool(newClient(server))
+
+	output, err := tool.Handler(t.Context(), tools.CompleteWorkflowTaskInput{
+		TaskID:         "task-1",
+		FormProperties: map[string]string{"approved": "yes"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || len(output.ValidationErrors) != 1 {
+		t.Fatalf("Expected a validation error for a non-boolean value, got: %+v", output)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.CompleteWorkflowTaskInput{
+		TaskID:         "task-1",
+		FormProperties: map[string]string{"approved": "true"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected success, got: %+v", output)
+	}
+	if len(completed.TaskIDs) != 1 || completed.TaskIDs[0] != "task-1" || completed.TaskFormProperties["approved"] != "true" {
+		t.Errorf("Unexpected completion request: %+v", completed)
+	}
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type CopilotCitation struct {
+	AssetID string `json:"assetId,omitempty" jsonschema:"The UUID of the cited asset, empty when the citation is a link to something else"`
+	Name    string `json:"name,omitempty" jsonschema:"The name of the cited asset or the title of the link"`
+	Url     string `json:"url" jsonschema:"The URL of the cited asset or link"`
+}
+
+// copilotAnswer is the answer of a Copilot agent with the assets and links it cites.
+type copilotAnswer struct {
+	Text      string
+	Citations []CopilotCitation
+}
+
+type copilotAskFunc func(context.Context, *http.Client, clients.ToolRequest, clients.TextStreamHandler) (*clients.ToolResponse, error)
+
+// askCopilot asks the agent the question as a follow-up to the earlier questions of the MCP session, and records the
+// answer in the history. When the agent streams its answer, the text is relayed to the client as progress
+// notifications while it comes in.
+func askCopilot(ctx context.Context, collibraClient *http.Client, history *CopilotHistory, agent string, question string, origin string, ask copilotAskFunc) (*copilotAnswer, error) {
+	sessionID := chip.GetSessionId(ctx)
+	toolRequest := clients.NewToolRequest(question, history.Messages(sessionID, agent), copilotOriginUrl(ctx, origin))
+
+	chunks := 0
+	response, err := ask(ctx, collibraClient, toolRequest, func(text string) {
+		chunks++
+		chip.NotifyProgress(ctx, float64(chunks), text)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	answer := &copilotAnswer{Text: response.Text(), Citations: copilotCitations(ctx, response.References())}
+	if answer.Text == "" && len(answer.Citations) == 0 {
+		return nil, fmt.Errorf("empty response content")
+	}
+	history.Record(sessionID, agent, toolRequest.Message, answer.Text)
+	return answer, nil
+}
+
+// copilotCitations links the cited assets that come without a URL to their page.
+func copilotCitations(ctx context.Context, references []clients.ToolReference) []CopilotCitation {
+	citations := make([]CopilotCitation, len(references))
+	for i, reference := range references {
+		citations[i] = CopilotCitation{AssetID: reference.AssetID, Name: reference.Name, Url: reference.Url}
+		if citations[i].Url == "" {
+			citations[i].Url = copilotOriginUrl(ctx, reference.AssetID)
+		}
+	}
+	return citations
+}
+
+// copilotOriginUrl turns an asset UUID into the URL of its page; other values are taken to be URLs already.
+func copilotOriginUrl(ctx context.Context, origin string) string {
+	origin = strings.TrimSpace(origin)
+	if _, err := uuid.Parse(origin); err != nil {
+		return origin
+	}
+	collibraHost, ok := chip.GetCollibraHost(ctx)
+	if !ok {
+		slog.WarnContext(ctx, "Collibra instance URL unknown, links will be rendered without host")
+	}
+	return fmt.Sprintf("%s/asset/%s", strings.TrimSuffix(collibraHost, "/"), origin)
+}
@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"sync"
+	"time"
+
+	"github.com/collibra/chip/pkg/clients"
+)
+
+// maxCopilotHistorySessions bounds the number of MCP sessions kept; the least recently used session is forgotten first.
+const maxCopilotHistorySessions = 1000
+
+// CopilotHistory keeps the conversations with the Copilot agents per MCP session and agent, so follow-up questions
+// keep their context. Each conversation is bounded to the most recent turns, a turn being a question and its answer.
+// Clients without a session ID, such as stdio clients, share a single conversation per agent.
+type CopilotHistory struct {
+	mu       sync.Mutex
+	maxTurns int
+	sessions map[string]*copilotSession
+}
+
+type copilotSession struct {
+	lastUsed      time.Time
+	conversations map[string][]clients.ToolMessage
+}
+
+// NewCopilotHistory creates a history keeping at most maxTurns turns per conversation. With maxTurns 0 no history is
+// kept and every question is asked on its own.
+func NewCopilotHistory(maxTurns int) *CopilotHistory {
+	return &CopilotHistory{
+		maxTurns: maxTurns,
+		sessions: map[string]*copilotSession{},
+	}
+}
+
+// Messages returns a copy of the conversation of the session with the agent.
+func (h *CopilotHistory) Messages(sessionID string, agent string) []clients.ToolMessage {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	session, ok := h.sessions[sessionID]
+	if !ok {
+		return []clients.ToolMessage{}
+	}
+	session.lastUsed = time.Now()
+	return append([]clients.ToolMessage{}, session.conversations[agent]...)
+}
+
+// Record appends a question and its answer to the conversation of the session with the agent.
+func (h *CopilotHistory) Record(sessionID string, agent string, question clients.ToolMessage, answer string) {
+	if h.maxTurns <= 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	session, ok := h.sessions[sessionID]
+	if !ok {
+		if len(h.sessions) >= maxCopilotHistorySessions {
+			h.evictLeastRecentlyUsed()
+		}
+		session = &copilotSession{conversations: map[string][]clients.ToolMessage{}}
+		h.sessions[sessionID] = session
+	}
+	session.lastUsed = time.Now()
+
+	messages := append(session.conversations[agent], question, clients.NewToolMessage(clients.AssistantMessagerRole, answer, question.Context.OriginUrl))
+	if maxMessages := 2 * h.maxTurns; len(messages) > maxMessages {
+		messages = append([]clients.ToolMessage{}, messages[len(messages)-maxMessages:]...)
+	}
+	session.conversations[agent] = messages
+}
+
+// Reset forgets the conversation of the session with the agent, or with all agents when agent is empty. It returns the
+// number of messages forgotten.
+func (h *CopilotHistory) Reset(sessionID string, agent string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	session, ok := h.sessions[sessionID]
+	if !ok {
+		return 0
+	}
+
+	cleared := 0
+	for name, messages := range session.conversations {
+		if agent == "" || name == agent {
+			cleared += len(messages)
+			delete(session.conversations, name)
+		}
+	}
+	if len(session.conversations) == 0 {
+		delete(h.sessions, sessionID)
+	}
+	return cleared
+}
+
+func (h *CopilotHistory) evictLeastRecentlyUsed() {
+	var oldestID string
+	var oldest time.Time
+	for id, session := range h.sessions {
+		if oldestID == "" || session.lastUsed.Before(oldest) {
+			oldestID, oldest = id, session.lastUsed
+		}
+	}
+	delete(h.sessions, oldestID)
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/classification"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+type CreateDataClassInput struct {
+	DataClassDefinition
+	Status string `json:"status,omitempty" jsonschema:"Optional. The status of the data class, e.g. DRAFT or PUBLISHED. Default: the Collibra default."`
+}
+
+func NewCreateDataClassTool(collibraClient *http.Client) *chip.Tool[CreateDataClassInput, DataClassWriteOutput] {
+	return &chip.Tool[CreateDataClassInput, DataClassWriteOutput]{
+		Name:        "data_class_create",
+		Description: "Create a data class in Collibra's classification service, with its column filters and its regex, column name and dictionary rules. The rules are checked before the data class is created. Use data_class_rule_test first to try the rules on sample columns.",
+		Handler:     handleCreateDataClass(collibraClient),
+	}
+}
+
+func handleCreateDataClass(collibraClient *http.Client) chip.ToolHandlerFunc[CreateDataClassInput, DataClassWriteOutput] {
+	return func(ctx context.Context, input CreateDataClassInput) (DataClassWriteOutput, error) {
+		name := strings.TrimSpace(input.Name)
+		if name == "" {
+			return DataClassWriteOutput{Success: false, Error: "Name is required"}, nil
+		}
+		if _, err := classification.Compile(input.class()); err != nil {
+			return DataClassWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+
+		request := clients.AddDataClassRequest{
+			Name:              name,
+			Description:       input.Description,
+			Status:            input.Status,
+			ColumnNameFilters: input.ColumnNameFilters,
+			ColumnTypeFilters: input.ColumnTypeFilters,
+			AllowNullValues:   &input.AllowNullValues,
+			AllowEmptyValues:  &input.AllowEmptyValues,
+			Examples:          input.Examples,
+			Rules:             input.Rules,
+		}
+		if input.ConfidenceThreshold != 0 {
+			request.ConfidenceThreshold = &input.ConfidenceThreshold
+		}
+
+		dataClass, err := clients.AddDataClass(ctx, collibraClient, request)
+		if err != nil {
+			return DataClassWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		return DataClassWriteOutput{DataClass: dataClass, Success: true}, nil
+	}
+}
@@ -0,0 +1,64 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestCreateDataClass(t *testing.T) {
+	var received clients.AddDataClassRequest
+	handler := http.NewServeMux()
+	handler.Handle("POST /rest/classification/v1/dataClasses", JsonHandlerInOut(func(_ *http.Request, request clients.AddDataClassRequest) (int, clients.DataClass) {
+		received = request
+		return http.StatusCreated, clients.DataClass{ID: "cccccccc-0000-0000-0000-000000000001", Name: request.Name, ConfidenceThreshold: *request.ConfidenceThreshold}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewCreateDataClassTool(newClient(server)).Handler(t.Context(), tools.CreateDataClassInput{
+		DataClassDefinition: tools.DataClassDefinition{
+			Name:                " Email Address ",
+			ConfidenceThreshold: 90,
+			Rules: []clients.DataClassRule{
+				{Name: "email", Type: clients.DataClassRuleTypeRegex, Pattern: `.+@.+\..+`},
+				{Type: clients.DataClassRuleTypeColumnName, Pattern: `.*mail.*`},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.DataClass == nil || output.DataClass.ID == "" {
+		t.Fatalf("Expected the data class to be created, got: %+v", output)
+	}
+	if received.Name != "Email Address" || len(received.Rules) != 2 || received.Rules[0].Pattern != `.+@.+\..+` {
+		t.Errorf("Unexpected request: %+v", received)
+	}
+}
+
+func TestCreateDataClass_InvalidRule(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request, got: %s %s", r.Method, r.URL.Path)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewCreateDataClassTool(newClient(server)).Handler(t.Context(), tools.CreateDataClassInput{
+		DataClassDefinition: tools.DataClassDefinition{
+			Name:  "Email Address",
+			Rules: []clients.DataClassRule{{Name: "email", Type: clients.DataClassRuleTypeRegex, Pattern: `[a-z+@`}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || !strings.Contains(output.Error, "email") {
+		t.Errorf("Expected the invalid rule to be reported, got: %+v", output)
+	}
+}
@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/google/uuid"
+)
+
+type CreateGlossaryTermInput struct {
+	Name         string   `json:"name" jsonschema:"Required. The name of the business term."`
+	DomainID     string   `json:"domainId" jsonschema:"Required. The UUID of the glossary domain to create the term in."`
+	Definition   string   `json:"definition" jsonschema:"Required. The definition of the term. It should not use the term itself."`
+	Acronyms     []string `json:"acronyms,omitempty" jsonschema:"Optional. The acronyms of the term."`
+	Synonyms     []string `json:"synonyms,omitempty" jsonschema:"Optional. Existing business terms that are synonyms of the term, given as names or UUIDs."`
+	RelatedTerms []string `json:"relatedTerms,omitempty" jsonschema:"Optional. Existing business terms related to the term, given as names or UUIDs."`
+	DryRun       bool     `json:"dryRun,omitempty" jsonschema:"Optional. Set to true to only run the quality checks without creating the term. Default: false."`
+}
+
+type GlossaryTermWriteOutput struct {
+	Term    *GlossaryTerm   `json:"term,omitempty" jsonschema:"The business term as written"`
+	Checks  []GlossaryCheck `json:"checks" jsonschema:"The findings of the quality checks. Findings with severity error prevent the write."`
+	Success bool            `json:"success" jsonschema:"Whether the term was written, or for a dry run whether it passed the checks"`
+	Error   string          `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+}
+
+func NewCreateGlossaryTermTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[CreateGlossaryTermInput, GlossaryTermWriteOutput] {
+	return &chip.Tool[CreateGlossaryTermInput, GlossaryTermWriteOutput]{
+		Name:        "glossary_term_create",
+		Description: "Draft a new business term in a glossary domain, in Candidate status, with its definition, acronyms, synonyms and related terms. The term is first checked for a missing definition, a circular definition and terms of the same name in the domain; it is only created when no check fails. Use dryRun to only run the checks.",
+		Handler:     handleCreateGlossaryTerm(collibraClient, metamodelCache),
+	}
+}
+
+func handleCreateGlossaryTerm(collibraClient *http.Client, metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[CreateGlossaryTermInput, GlossaryTermWriteOutput] {
+	return func(ctx context.Context, input CreateGlossaryTermInput) (GlossaryTermWriteOutput, error) {
+		input.Name = strings.TrimSpace(input.Name)
+		if input.Name == "" {
+			return GlossaryTermWriteOutput{Success: false, Error: "Name is required"}, nil
+		}
+		if _, err := uuid.Parse(input.DomainID); err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: fmt.Sprintf("Invalid domain ID format: %s", err.Error())}, nil
+		}
+
+		gm, err := loadGlossaryMetamodel(ctx, metamodelCache)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		synonymIDs, err := resolveGlossaryTermNames(ctx, collibraClient, gm, input.Synonyms)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		relatedIDs, err := resolveGlossaryTermNames(ctx, collibraClient, gm, input.RelatedTerms)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+
+		checks, err := checkGlossaryTerm(ctx, collibraClient, gm, glossaryTermDraft{
+			DomainID:          input.DomainID,
+			Name:              input.Name,
+			Definition:        input.Definition,
+			ReferencedTermIDs: append(append([]string{}, synonymIDs...), relatedIDs...),
+		})
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		if hasBlockingCheck(checks) {
+			return GlossaryTermWriteOutput{Checks: checks, Success: false, Error: "The term did not pass the quality checks"}, nil
+		}
+		if input.DryRun {
+			return GlossaryTermWriteOutput{Checks: checks, Success: true}, nil
+		}
+
+		asset, err := clients.AddAsset(ctx, collibraClient, clients.AddAssetRequest{
+			Name:     input.Name,
+			DomainID: input.DomainID,
+			TypeID:   gm.termTypeID,
+			StatusID: gm.candidateStatusID,
+		})
+		if err != nil {
+			return GlossaryTermWriteOutput{Checks: checks, Success: false, Error: fmt.Sprintf("Failed to create business term: %s", err.Error())}, nil
+		}
+
+		if err := writeGlossaryTermDetails(ctx, collibraClient, gm, asset.ID, input.Definition, "", input.Acronyms, synonymIDs, relatedIDs); err != nil {
+			return GlossaryTermWriteOutput{
+				Checks:  checks,
+				Success: false,
+				Error:   fmt.Sprintf("Created business term %s, but %s", asset.ID, err.Error()),
+			}, nil
+		}
+
+		term, err := getGlossaryTerm(ctx, collibraClient, gm, asset.ID)
+		if err != nil {
+			return GlossaryTermWriteOutput{Checks: checks, Success: true, Error: fmt.Sprintf("Created business term %s, but failed to retrieve it: %s", asset.ID, err.Error())}, nil
+		}
+		return GlossaryTermWriteOutput{Term: term, Checks: checks, Success: true}, nil
+	}
+}
+
+// writeGlossaryTermDetails sets the definition, replacing the existing definition attribute if there is one, and adds
+// the acronyms, synonyms and related terms.
+func writeGlossaryTermDetails(ctx context.Context, collibraClient *http.Client, gm glossaryMetamodel, termID string, definition string, definitionAttributeID string, acronyms []string, synonymIDs []string, relatedIDs []string) error {
+	if definition != "" {
+		var err error
+		if definitionAttributeID != "" {
+			_, err = clients.ChangeAttribute(ctx, collibraClient, definitionAttributeID, clients.ChangeAttributeRequest{Value: definition})
+		} else {
+			_, err = clients.AddAttribute(ctx, collibraClient, clients.AddAttributeRequest{AssetID: termID, TypeID: gm.definitionTypeID, Value: definition})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to set the definition: %w", err)
+		}
+	}
+
+	for _, acronym := range acronyms {
+		if acronym = strings.TrimSpace(acronym); acronym == "" {
+			continue
+		}
+		if _, err := clients.AddAttribute(ctx, collibraClient, clients.AddAttributeRequest{AssetID: termID, TypeID: gm.acronymTypeID, Value: acronym}); err != nil {
+			return fmt.Errorf("failed to add acronym '%s': %w", acronym, err)
+		}
+	}
+
+	for _, relation := range []struct {
+		typeID string
+		kind   string
+		ids    []string
+	}{
+		{gm.synonymTypeID, "synonym", synonymIDs},
+		{gm.relatedTypeID, "related term", relatedIDs},
+	} {
+		for _, id := range relation.ids {
+			if _, err := clients.AddRelation(ctx, collibraClient, clients.AddRelationRequest{SourceID: termID, TargetID: id, TypeID: relation.typeID}); err != nil {
+				return fmt.Errorf("failed to add %s %s: %w", relation.kind, id, err)
+			}
+		}
+	}
+	return nil
+}
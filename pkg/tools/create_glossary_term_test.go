@@ -0,0 +1,142 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestCreateGlossaryTerm(t *testing.T) {
+	glossary := newFakeGlossary()
+	clientID := glossary.addTerm("Client", "Sales Glossary", "A party that buys goods or services.")
+	glossary.addTerm("Order", "Sales Glossary", "A request to deliver goods.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewCreateGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.CreateGlossaryTermInput{
+		Name:         "Customer",
+		DomainID:     glossaryDomainID,
+		Definition:   "A party that buys goods or services from us.",
+		Acronyms:     []string{"CUST"},
+		Synonyms:     []string{clientID},
+		RelatedTerms: []string{"Order"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Term == nil {
+		t.Fatalf("Expected the term to be created, got: %+v", output)
+	}
+	term := output.Term
+	if term.Status != "Candidate" {
+		t.Errorf("Expected the term to be drafted as Candidate, got: %s", term.Status)
+	}
+	if term.Definition != "A party that buys goods or services from us." {
+		t.Errorf("Unexpected definition: %s", term.Definition)
+	}
+	if len(term.Acronyms) != 1 || term.Acronyms[0] != "CUST" {
+		t.Errorf("Unexpected acronyms: %v", term.Acronyms)
+	}
+	if len(term.Synonyms) != 1 || term.Synonyms[0].Name != "Client" {
+		t.Errorf("Unexpected synonyms: %+v", term.Synonyms)
+	}
+	if len(term.RelatedTerms) != 1 || term.RelatedTerms[0].Name != "Order" {
+		t.Errorf("Unexpected related terms: %+v", term.RelatedTerms)
+	}
+}
+
+func TestCreateGlossaryTerm_FailedChecks(t *testing.T) {
+	glossary := newFakeGlossary()
+	glossary.addTerm("Customer", "Sales Glossary", "A party with a sales contract.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	tool := tools.NewCreateGlossaryTermTool(client, newMetamodelCache(client))
+
+	testCases := []struct {
+		name     string
+		input    tools.CreateGlossaryTermInput
+		expected string
+	}{
+		{
+			name:     "missing definition",
+			input:    tools.CreateGlossaryTermInput{Name: "Account", DomainID: glossaryDomainID},
+			expected: "missing_definition",
+		},
+		{
+			name:     "definition uses the term",
+			input:    tools.CreateGlossaryTermInput{Name: "Account", DomainID: glossaryDomainID, Definition: "An account held at the bank."},
+			expected: "circular_definition",
+		},
+		{
+			name:     "duplicate",
+			input:    tools.CreateGlossaryTermInput{Name: "Customer", DomainID: glossaryDomainID, Definition: "A party that buys goods or services."},
+			expected: "duplicate_term",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := tool.Handler(t.Context(), tc.input)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if output.Success || output.Term != nil {
+				t.Fatalf("Expected the term to be rejected, got: %+v", output)
+			}
+			found := false
+			for _, check := range output.Checks {
+				found = found || (check.Check == tc.expected && check.Severity == "error")
+			}
+			if !found {
+				t.Errorf("Expected a %s check, got: %+v", tc.expected, output.Checks)
+			}
+		})
+	}
+}
+
+func TestCreateGlossaryTerm_CircularWithSynonym(t *testing.T) {
+	glossary := newFakeGlossary()
+	clientID := glossary.addTerm("Client", "Sales Glossary", "A customer of the bank.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewCreateGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.CreateGlossaryTermInput{
+		Name:       "Customer",
+		DomainID:   glossaryDomainID,
+		Definition: "A client of the bank.",
+		Synonyms:   []string{clientID},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || len(output.Checks) != 1 || output.Checks[0].Check != "circular_definition" {
+		t.Errorf("Expected a circular definition, got: %+v", output)
+	}
+}
+
+func TestCreateGlossaryTerm_DryRun(t *testing.T) {
+	glossary := newFakeGlossary()
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewCreateGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.CreateGlossaryTermInput{
+		Name:       "Customer",
+		DomainID:   glossaryDomainID,
+		Definition: "A party that buys goods or services.",
+		DryRun:     true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Term != nil {
+		t.Errorf("Expected the checks to pass without creating the term, got: %+v", output)
+	}
+	if len(glossary.assets) != 0 {
+		t.Errorf("Expected no term to be created on a dry run")
+	}
+}
@@ -0,0 +1,39 @@
+package tools
+
+import (
+	"strings"
+
+	"github.com/collibra/chip/pkg/classification"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+// DataClassDefinition describes a data class and its rules, as written to Collibra or tested locally.
+type DataClassDefinition struct {
+	Name                string                  `json:"name" jsonschema:"Required. The name of the data class."`
+	Description         string                  `json:"description,omitempty" jsonschema:"Optional. The description of the data class."`
+	ColumnNameFilters   []string                `json:"columnNameFilters,omitempty" jsonschema:"Optional. Regular expressions of which one must match the whole column name for the data class to apply."`
+	ColumnTypeFilters   []string                `json:"columnTypeFilters,omitempty" jsonschema:"Optional. Column data types, e.g. VARCHAR, of which one must be the column data type for the data class to apply."`
+	AllowNullValues     bool                    `json:"allowNullValues,omitempty" jsonschema:"Optional. Whether null values are ignored rather than counted as not matching. Default: false."`
+	AllowEmptyValues    bool                    `json:"allowEmptyValues,omitempty" jsonschema:"Optional. Whether empty values are ignored rather than counted as not matching. Default: false."`
+	ConfidenceThreshold int                     `json:"confidenceThreshold,omitempty" jsonschema:"Optional. The percentage of values a rule must match for the column to be classified, from 1 to 100. Default: 80."`
+	Examples            []string                `json:"examples,omitempty" jsonschema:"Optional. Example values of the data class."`
+	Rules               []clients.DataClassRule `json:"rules,omitempty" jsonschema:"Optional. The rules of the data class. Each rule has a type and, depending on the type, a pattern or values: REGEX matches column values against the regular expression in pattern, COLUMN_NAME matches the column name against the regular expression in pattern, DICTIONARY matches column values against the list in values. Patterns must match the whole value. Matching ignores case unless caseSensitive is true."`
+}
+
+type DataClassWriteOutput struct {
+	DataClass *clients.DataClass `json:"dataClass,omitempty" jsonschema:"The data class as written"`
+	Success   bool               `json:"success" jsonschema:"Whether the data class was written"`
+	Error     string             `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+}
+
+func (d DataClassDefinition) class() classification.Class {
+	return classification.Class{
+		Name:                strings.TrimSpace(d.Name),
+		ColumnNameFilters:   d.ColumnNameFilters,
+		ColumnTypeFilters:   d.ColumnTypeFilters,
+		AllowNullValues:     d.AllowNullValues,
+		AllowEmptyValues:    d.AllowEmptyValues,
+		ConfidenceThreshold: d.ConfidenceThreshold,
+		Rules:               d.Rules,
+	}
+}
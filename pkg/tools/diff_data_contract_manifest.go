@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/datacontract"
+	"github.com/google/uuid"
+)
+
+type DiffDataContractManifestInput struct {
+	Manifest       string `json:"manifest" jsonschema:"Required. The content of the new data contract manifest file, following the Open Data Contract Standard v2 or v3."`
+	DataContractID string `json:"dataContractId,omitempty" jsonschema:"Optional. The UUID of the data contract asset whose active manifest to compare with. If omitted, the data contract is found by the id of the new manifest (its uuid in ODCS v2)."`
+}
+
+type DiffDataContractManifestOutput struct {
+	DataContractID string                `json:"dataContractId,omitempty" jsonschema:"The UUID of the data contract asset compared with"`
+	ActiveVersion  string                `json:"activeVersion,omitempty" jsonschema:"The version of the active manifest"`
+	NewVersion     string                `json:"newVersion,omitempty" jsonschema:"The version of the new manifest"`
+	Changes        []datacontract.Change `json:"changes" jsonschema:"The changes from the active to the new manifest, by category (schema, sla, quality or ownership) and element, each marked as breaking or not"`
+	Breaking       int                   `json:"breaking" jsonschema:"The number of breaking changes"`
+	NonBreaking    int                   `json:"nonBreaking" jsonschema:"The number of non-breaking changes"`
+	Found          bool                  `json:"found" jsonschema:"Whether an active manifest was found to compare with"`
+	Error          string                `json:"error,omitempty" jsonschema:"Error message if the manifests could not be compared"`
+}
+
+func NewDiffDataContractManifestTool(collibraClient *http.Client) *chip.Tool[DiffDataContractManifestInput, DiffDataContractManifestOutput] {
+	return &chip.Tool[DiffDataContractManifestInput, DiffDataContractManifestOutput]{
+		Name:        "data_contract_manifest_diff",
+		Description: "Compare a new data contract manifest with the active version in Collibra. Both must follow the Open Data Contract Standard v2 or v3. Reports added, removed and retyped columns, SLA and quality rule changes, and ownership changes, each classified as breaking or non-breaking for the consumers of the data contract.",
+		Handler:     handleDiffDataContractManifest(collibraClient),
+	}
+}
+
+func handleDiffDataContractManifest(collibraClient *http.Client) chip.ToolHandlerFunc[DiffDataContractManifestInput, DiffDataContractManifestOutput] {
+	return func(ctx context.Context, input DiffDataContractManifestInput) (DiffDataContractManifestOutput, error) {
+		next, err := datacontract.Parse([]byte(input.Manifest))
+		if err != nil {
+			return DiffDataContractManifestOutput{Error: fmt.Sprintf("Failed to read the new manifest: %s", err.Error())}, nil
+		}
+		if input.DataContractID != "" {
+			if _, err := uuid.Parse(input.DataContractID); err != nil {
+				return DiffDataContractManifestOutput{Error: fmt.Sprintf("Invalid data contract ID format: %s", err.Error())}, nil
+			}
+		}
+
+		active, err := pullActiveManifest(ctx, collibraClient, input.DataContractID, next.ID)
+		if err != nil {
+			return DiffDataContractManifestOutput{Error: err.Error()}, nil
+		}
+		if active == nil {
+			return DiffDataContractManifestOutput{NewVersion: next.Version, Changes: []datacontract.Change{}, Error: fmt.Sprintf("No data contract found with manifest ID '%s'", next.ID)}, nil
+		}
+		previous, err := datacontract.Parse(active.manifest)
+		if err != nil {
+			return DiffDataContractManifestOutput{DataContractID: active.dataContractID, Found: true, Error: fmt.Sprintf("Failed to read the active manifest: %s", err.Error())}, nil
+		}
+
+		output := DiffDataContractManifestOutput{
+			DataContractID: active.dataContractID,
+			ActiveVersion:  previous.Version,
+			NewVersion:     next.Version,
+			Changes:        datacontract.Diff(previous, next),
+			Found:          true,
+		}
+		for _, change := range output.Changes {
+			if change.Breaking {
+				output.Breaking++
+			} else {
+				output.NonBreaking++
+			}
+		}
+		return output, nil
+	}
+}
+
+type activeManifest struct {
+	dataContractID string
+	manifest       []byte
+}
+
+// pullActiveManifest pulls the active manifest of a data contract, found by its asset ID or, when empty, by its
+// manifest ID. It returns nil when no data contract has the manifest ID.
+func pullActiveManifest(ctx context.Context, collibraClient *http.Client, dataContractID string, manifestID string) (*activeManifest, error) {
+	if dataContractID == "" {
+		if manifestID == "" {
+			return nil, fmt.Errorf("the manifest has no id to find its data contract by")
+		}
+		contracts, err := clients.ListDataContracts(ctx, collibraClient, "", 1, manifestID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the data contract: %w", err)
+		}
+		if len(contracts.Items) == 0 {
+			return nil, nil
+		}
+		dataContractID = contracts.Items[0].ID
+	}
+
+	manifest, err := clients.PullActiveDataContractManifest(ctx, collibraClient, dataContractID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the active manifest: %w", err)
+	}
+	return &activeManifest{dataContractID: dataContractID, manifest: manifest}, nil
+}
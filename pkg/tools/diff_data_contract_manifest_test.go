@@ -0,0 +1,112 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/datacontract"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+const activeOrdersManifest = `apiVersion: v2.2.2
+kind: DataContract
+uuid: orders-contract
+version: 1.0.0
+status: current
+datasetName: orders
+quantumName: Orders
+type: tables
+dataset:
+  - table: orders
+    columns:
+      - column: order_id
+        logicalType: integer
+      - column: amount
+        logicalType: number
+stakeholders:
+  - username: ann
+    role: owner
+slaProperties:
+  - property: latency
+    value: 4
+    unit: h`
+
+func TestDiffDataContractManifest(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.Handle("/rest/dataProduct/v1/dataContracts/{id}/activeVersion/manifest", StringHandlerOut(func(r *http.Request) (int, string) {
+		if r.PathValue("id") != "00000000-0000-0000-0000-000000000005" {
+			return http.StatusNotFound, "not found"
+		}
+		return http.StatusOK, activeOrdersManifest
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewDiffDataContractManifestTool(newClient(server)).Handler(t.Context(), tools.DiffDataContractManifestInput{
+		DataContractID: "00000000-0000-0000-0000-000000000005",
+		Manifest: `apiVersion: v3.0.2
+kind: DataContract
+id: orders-contract
+version: 2.0.0
+status: active
+schema:
+  - name: orders
+    properties:
+      - name: order_id
+        logicalType: integer
+      - name: currency
+        logicalType: string
+team:
+  - username: bob
+    role: owner
+slaProperties:
+  - property: latency
+    value: 4
+    unit: h`,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Found || output.Error != "" || output.ActiveVersion != "1.0.0" || output.NewVersion != "2.0.0" {
+		t.Fatalf("Expected the manifests to be compared, got: %+v", output)
+	}
+	var described []string
+	for _, change := range output.Changes {
+		described = append(described, change.String())
+	}
+	expected := "schema orders.amount removed: number|schema orders.currency added: string|ownership ann removed: owner|ownership bob added: owner"
+	if strings.Join(described, "|") != expected {
+		t.Errorf("Unexpected changes: %v", described)
+	}
+	if output.Breaking != 1 || output.NonBreaking != 3 || output.Changes[0].Category != datacontract.CategorySchema || !output.Changes[0].Breaking {
+		t.Errorf("Expected only the removed column to be breaking, got: %+v", output.Changes)
+	}
+}
+
+func TestDiffDataContractManifest_UnknownContract(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.Handle("/rest/dataProduct/v1/dataContracts", StringHandlerOut(func(r *http.Request) (int, string) {
+		return http.StatusOK, `{"items": [], "limit": 1}`
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewDiffDataContractManifestTool(newClient(server))
+	output, err := tool.Handler(t.Context(), tools.DiffDataContractManifestInput{Manifest: activeOrdersManifest})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || !strings.Contains(output.Error, "orders-contract") {
+		t.Errorf("Expected no data contract to be found, got: %+v", output)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.DiffDataContractManifestInput{Manifest: "id: orders\napiVersion: 1.0.3"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error == "" {
+		t.Errorf("Expected a manifest that is not ODCS to be rejected")
+	}
+}
@@ -0,0 +1,227 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+)
+
+const (
+	fallbackSearchLimit   = 20
+	fallbackEvidenceLimit = 10
+	fallbackMaxKeywords   = 8
+	fallbackExcerptLength = 300
+
+	fallbackUnavailableExplanation = "The AI Copilot is not available on this Collibra instance, so the question was answered by searching for its keywords."
+)
+
+var (
+	fallbackWordPattern = regexp.MustCompile(`[\p{L}\p{N}_]+`)
+	fallbackStopWords   = []string{
+		"a", "about", "all", "an", "and", "any", "are", "can", "contain", "contains", "data", "define", "defined",
+		"definition", "describe", "do", "does", "find", "for", "from", "have", "how", "in", "is", "it", "list", "me",
+		"mean", "means", "of", "on", "or", "our", "show", "that", "the", "there", "this", "to", "we", "what", "where",
+		"which", "who", "with",
+	}
+	fallbackGlossaryAssetTypes    = []string{"Business Term", "Acronym", "KPI"}
+	fallbackDataAssetTypes        = []string{"Database", "Schema", "Table", "Column", "Data Set", "Report"}
+	fallbackDescriptionAttributes = []string{"Definition", "Description"}
+)
+
+type DiscoverFallbackOutput struct {
+	Answer    string             `json:"output" jsonschema:"a summary of the assets found for the question"`
+	Citations []CopilotCitation  `json:"citations" jsonschema:"the assets the summary is based on"`
+	Keywords  []string           `json:"keywords" jsonschema:"the keywords searched for, taken from the question"`
+	Evidence  []DiscoverEvidence `json:"evidence" jsonschema:"the assets found, best match first"`
+}
+
+type DiscoverEvidence struct {
+	ID              string   `json:"id" jsonschema:"The UUID of the asset"`
+	Name            string   `json:"name" jsonschema:"The display name of the asset"`
+	AssetType       string   `json:"assetType,omitempty" jsonschema:"The asset type"`
+	Domain          string   `json:"domain,omitempty" jsonschema:"The name of the domain of the asset"`
+	Community       string   `json:"community,omitempty" jsonschema:"The name of the community of the asset"`
+	Excerpt         string   `json:"excerpt,omitempty" jsonschema:"The start of the definition or description of the asset"`
+	MatchedKeywords []string `json:"matchedKeywords" jsonschema:"The keywords found in the name, definition or description"`
+	Score           int      `json:"score" jsonschema:"How well the asset matches the question, higher is better"`
+	Link            string   `json:"link" jsonschema:"The link to view the asset in Collibra"`
+}
+
+// NewDiscoverAssetsFallbackTool answers data asset questions without the AI Copilot. It is registered instead of the
+// Copilot tool of the same name on instances without the Copilot.
+func NewDiscoverAssetsFallbackTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[AskDadInput, DiscoverFallbackOutput] {
+	return &chip.Tool[AskDadInput, DiscoverFallbackOutput]{
+		Name:        "data_assets_discover",
+		Description: "Find the data assets in Collibra relevant to a question. The question's keywords are searched for among databases, schemas, tables, columns, data sets and reports, and the matches are returned ranked, with their descriptions, as evidence to answer from.",
+		Handler: func(ctx context.Context, input AskDadInput) (DiscoverFallbackOutput, error) {
+			return discoverFallback(ctx, collibraClient, metamodelCache, input.Question, fallbackDataAssetTypes)
+		},
+	}
+}
+
+// NewDiscoverGlossaryFallbackTool answers glossary questions without the AI Copilot. It is registered instead of the
+// Copilot tool of the same name on instances without the Copilot.
+func NewDiscoverGlossaryFallbackTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[AskGlossaryInput, DiscoverFallbackOutput] {
+	return &chip.Tool[AskGlossaryInput, DiscoverFallbackOutput]{
+		Name:        "business_glossary_discover",
+		Description: "Find the business terms, acronyms and KPIs in Collibra relevant to a question. The question's keywords are searched for in the glossary, and the matches are returned ranked, with their definitions, as evidence to answer from.",
+		Handler: func(ctx context.Context, input AskGlossaryInput) (DiscoverFallbackOutput, error) {
+			return discoverFallback(ctx, collibraClient, metamodelCache, input.Question, fallbackGlossaryAssetTypes)
+		},
+	}
+}
+
+func discoverFallback(ctx context.Context, collibraClient *http.Client, metamodelCache *metamodel.Cache, question string, assetTypeNames []string) (DiscoverFallbackOutput, error) {
+	keywords := extractKeywords(question)
+	if len(keywords) == 0 {
+		return DiscoverFallbackOutput{}, fmt.Errorf("no keywords to search for in the question '%s'", question)
+	}
+
+	mm, err := metamodelCache.Get(ctx)
+	if err != nil {
+		return DiscoverFallbackOutput{}, err
+	}
+	var assetTypeIDs, attributeTypeIDs []string
+	for _, name := range assetTypeNames {
+		for _, assetType := range mm.AssetTypesByName(name) {
+			assetTypeIDs = append(assetTypeIDs, assetType.ID)
+		}
+	}
+	for _, name := range fallbackDescriptionAttributes {
+		for _, attributeType := range mm.AttributeTypesByName(name) {
+			attributeTypeIDs = append(attributeTypeIDs, attributeType.ID)
+		}
+	}
+
+	var filters []clients.SearchFilter
+	if len(assetTypeIDs) > 0 {
+		filters = append(filters, clients.SearchFilter{Field: "assetType", Values: assetTypeIDs})
+	} else {
+		slog.WarnContext(ctx, fmt.Sprintf("None of the asset types %v exist, searching all assets", assetTypeNames))
+	}
+	searchRequest := clients.CreateSearchRequest("", []string{"Asset"}, filters, fallbackSearchLimit, 0)
+	searchRequest.Keywords = strings.Join(keywords, " OR ")
+	searchResponse, err := clients.SearchKeyword(ctx, collibraClient, searchRequest)
+	if err != nil {
+		return DiscoverFallbackOutput{}, fmt.Errorf("failed to search for the keywords: %w", err)
+	}
+
+	positions := map[string]int{}
+	var assetIDs []string
+	for _, result := range searchResponse.Results {
+		if result.Resource.ResourceType == "Asset" {
+			positions[result.Resource.ID] = len(assetIDs)
+			assetIDs = append(assetIDs, result.Resource.ID)
+		}
+	}
+	output := DiscoverFallbackOutput{Keywords: keywords, Citations: []CopilotCitation{}, Evidence: []DiscoverEvidence{}}
+	if len(assetIDs) == 0 {
+		output.Answer = fmt.Sprintf("%s No assets were found for: %s.", fallbackUnavailableExplanation, strings.Join(keywords, ", "))
+		return output, nil
+	}
+
+	assets, err := clients.GetAssetsOverview(ctx, collibraClient, assetIDs)
+	if err != nil {
+		return DiscoverFallbackOutput{}, fmt.Errorf("failed to retrieve the assets found: %w", err)
+	}
+	for _, asset := range assets {
+		evidence := rankEvidence(asset, keywords, len(assetIDs)-positions[asset.ID])
+		evidence.Link = copilotOriginUrl(ctx, asset.ID)
+		output.Evidence = append(output.Evidence, evidence)
+	}
+	slices.SortStableFunc(output.Evidence, func(a, b DiscoverEvidence) int { return b.Score - a.Score })
+	output.Evidence = output.Evidence[:min(len(output.Evidence), fallbackEvidenceLimit)]
+
+	// The descriptions are only retrieved for the evidence kept, and then rank it again.
+	if len(attributeTypeIDs) > 0 {
+		for i := range output.Evidence {
+			addEvidenceExcerpt(ctx, collibraClient, &output.Evidence[i], attributeTypeIDs, keywords)
+		}
+		slices.SortStableFunc(output.Evidence, func(a, b DiscoverEvidence) int { return b.Score - a.Score })
+	}
+
+	lines := []string{fmt.Sprintf("%s These assets match best:", fallbackUnavailableExplanation)}
+	for i, evidence := range output.Evidence {
+		line := fmt.Sprintf("%d. %s", i+1, evidence.Name)
+		if evidence.AssetType != "" || evidence.Domain != "" {
+			line += fmt.Sprintf(" (%s)", strings.Trim(evidence.AssetType+", "+evidence.Domain, ", "))
+		}
+		if evidence.Excerpt != "" {
+			line += ": " + evidence.Excerpt
+		}
+		lines = append(lines, line)
+		output.Citations = append(output.Citations, CopilotCitation{AssetID: evidence.ID, Name: evidence.Name, Url: evidence.Link})
+	}
+	output.Answer = strings.Join(lines, "\n")
+	return output, nil
+}
+
+// extractKeywords returns the distinct words of the question that are worth searching for, in order.
+func extractKeywords(question string) []string {
+	var keywords []string
+	for _, word := range fallbackWordPattern.FindAllString(strings.ToLower(question), -1) {
+		if len([]rune(word)) < 3 || slices.Contains(fallbackStopWords, word) || slices.Contains(keywords, word) {
+			continue
+		}
+		keywords = append(keywords, word)
+		if len(keywords) == fallbackMaxKeywords {
+			break
+		}
+	}
+	return keywords
+}
+
+// rankEvidence scores the asset by its position in the search results and the keywords in its name.
+func rankEvidence(asset clients.Asset, keywords []string, searchScore int) DiscoverEvidence {
+	evidence := DiscoverEvidence{ID: asset.ID, Name: asset.DisplayName, MatchedKeywords: []string{}, Score: searchScore}
+	if asset.Type != nil {
+		evidence.AssetType = asset.Type.Name
+	}
+	if asset.Domain != nil {
+		evidence.Domain = asset.Domain.Name
+		if asset.Domain.Parent != nil {
+			evidence.Community = asset.Domain.Parent.Name
+		}
+	}
+	for _, keyword := range keywords {
+		if strings.Contains(strings.ToLower(asset.DisplayName), keyword) {
+			evidence.Score += 10
+			evidence.MatchedKeywords = append(evidence.MatchedKeywords, keyword)
+		}
+	}
+	return evidence
+}
+
+// addEvidenceExcerpt adds the definition or description of the asset, scoring the keywords found in it.
+func addEvidenceExcerpt(ctx context.Context, collibraClient *http.Client, evidence *DiscoverEvidence, attributeTypeIDs []string, keywords []string) {
+	attributes, err := clients.FindAttributes(ctx, collibraClient, clients.AttributesQueryParams{AssetID: evidence.ID, TypeIDs: attributeTypeIDs, Limit: 10})
+	if err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Failed to retrieve the description of asset %s: %v", evidence.ID, err))
+		return
+	}
+	for _, attribute := range attributes.Results {
+		text := strings.TrimSpace(htmlToText(fmt.Sprint(attribute.Value)))
+		if text == "" {
+			continue
+		}
+		for _, keyword := range keywords {
+			if !slices.Contains(evidence.MatchedKeywords, keyword) && strings.Contains(strings.ToLower(text), keyword) {
+				evidence.Score += 3
+				evidence.MatchedKeywords = append(evidence.MatchedKeywords, keyword)
+			}
+		}
+		if runes := []rune(text); len(runes) > fallbackExcerptLength {
+			text = string(runes[:fallbackExcerptLength]) + "…"
+		}
+		evidence.Excerpt = text
+		return
+	}
+}
@@ -0,0 +1,93 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestDiscoverGlossaryFallback(t *testing.T) {
+	const (
+		termTypeID       = "00000000-0000-0000-0000-000000011001"
+		definitionTypeID = "00000000-0000-0000-0000-000000000202"
+		revenueID        = "11111111-1111-1111-1111-111111111111"
+		recurringID      = "22222222-2222-2222-2222-222222222222"
+	)
+
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AssetTypes:     []clients.AssetTypeDetails{{ID: termTypeID, Name: "Business Term"}},
+		AttributeTypes: []clients.AttributeTypeDetails{{ID: definitionTypeID, Name: "Definition"}},
+	})
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(_ *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		if request.Keywords != "annual OR recurring OR revenue" {
+			t.Errorf("Unexpected keywords: '%s'", request.Keywords)
+		}
+		if len(request.Filters) != 1 || !reflect.DeepEqual(request.Filters[0].Values, []string{termTypeID}) {
+			t.Errorf("Expected the search to be restricted to business terms, got: %+v", request.Filters)
+		}
+		return http.StatusOK, clients.SearchResponse{Total: 2, Results: []clients.SearchResult{
+			{Resource: clients.SearchResource{ResourceType: "Asset", ID: revenueID, Name: "Revenue"}},
+			{Resource: clients.SearchResource{ResourceType: "Asset", ID: recurringID, Name: "Annual Recurring Revenue"}},
+		}}
+	}))
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(_ *http.Request, _ clients.Request) (int, clients.Response) {
+		return http.StatusOK, clients.Response{Data: &clients.AssetQueryData{Assets: []clients.Asset{
+			{ID: revenueID, DisplayName: "Revenue", Type: &clients.AssetType{Name: "Business Term"}, Domain: &clients.Domain{Name: "Finance Glossary"}},
+			{ID: recurringID, DisplayName: "Annual Recurring Revenue", Type: &clients.AssetType{Name: "Business Term"}, Domain: &clients.Domain{Name: "Finance Glossary"}},
+		}}}
+	}))
+	handler.Handle("/rest/2.0/attributes", JsonHandlerOut(func(r *http.Request) (int, clients.AttributePagedResponse) {
+		definitions := map[string]string{
+			revenueID:   "<p>Income from selling goods and services.</p>",
+			recurringID: "<p>The yearly value of subscription contracts.</p>",
+		}
+		return http.StatusOK, clients.AttributePagedResponse{Results: []clients.AttributeResource{
+			{Type: clients.NamedResourceReference{ID: definitionTypeID}, Value: definitions[r.URL.Query().Get("assetId")]},
+		}}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewDiscoverGlossaryFallbackTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AskGlossaryInput{
+		Question: "What is the definition of annual recurring revenue?",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(output.Keywords, []string{"annual", "recurring", "revenue"}) {
+		t.Errorf("Unexpected keywords: %v", output.Keywords)
+	}
+	if len(output.Evidence) != 2 || output.Evidence[0].ID != recurringID {
+		t.Fatalf("Expected the term matching all keywords first, got: %+v", output.Evidence)
+	}
+	if output.Evidence[0].Excerpt != "The yearly value of subscription contracts." {
+		t.Errorf("Unexpected excerpt: '%s'", output.Evidence[0].Excerpt)
+	}
+	if len(output.Citations) != 2 || output.Citations[0].AssetID != recurringID {
+		t.Errorf("Expected the evidence to be cited, got: %+v", output.Citations)
+	}
+	if !strings.Contains(output.Answer, "1. Annual Recurring Revenue (Business Term, Finance Glossary): The yearly value") {
+		t.Errorf("Unexpected answer: %s", output.Answer)
+	}
+}
+
+func TestDiscoverAssetsFallback_NoKeywords(t *testing.T) {
+	server := httptest.NewServer(http.NewServeMux())
+	defer server.Close()
+
+	client := newClient(server)
+	_, err := tools.NewDiscoverAssetsFallbackTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AskDadInput{Question: "What is it?"})
+	if err == nil {
+		t.Fatalf("Expected an error for a question without keywords")
+	}
+}
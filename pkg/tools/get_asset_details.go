@@ -5,35 +5,47 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/collibra/chip/pkg/chip"
 	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 	"github.com/google/uuid"
 )
 
 type AssetDetailsInput struct {
-	AssetID                 string `json:"assetId" jsonschema:"the UUID of the asset to retrieve details for"`
-	OutgoingRelationsCursor string `json:"outgoingRelationsCursor,omitempty" jsonschema:"Optional. Cursor (asset ID) to fetch the next page of outgoing relations. Use the last relation's target ID from the previous response."`
-	IncomingRelationsCursor string `json:"incomingRelationsCursor,omitempty" jsonschema:"Optional. Cursor (asset ID) to fetch the next page of incoming relations. Use the last relation's source ID from the previous response."`
+	AssetID                 string            `json:"assetId" jsonschema:"the UUID of the asset to retrieve details for"`
+	OutgoingRelationsCursor string            `json:"outgoingRelationsCursor,omitempty" jsonschema:"Optional. Cursor to fetch the next page of outgoing relations. Same as cursors.outgoingRelations."`
+	IncomingRelationsCursor string            `json:"incomingRelationsCursor,omitempty" jsonschema:"Optional. Cursor to fetch the next page of incoming relations. Same as cursors.incomingRelations."`
+	Cursors                 map[string]string `json:"cursors,omitempty" jsonschema:"Optional. Cursors to fetch the next page of sections, keyed by section name (stringAttributes, numericAttributes, booleanAttributes, dateAttributes, outgoingRelations, incomingRelations). Use the nextCursor of the section from the pages of the previous response."`
+	Sections                []string          `json:"sections,omitempty" jsonschema:"Optional. The sections to include besides the asset's type, domain and status: attributes, outgoingRelations, incomingRelations. Default: all sections."`
+	AttributeTypes          []string          `json:"attributeTypes,omitempty" jsonschema:"Optional. Only include attributes of these attribute types, given as names (e.g. 'Description') or UUIDs. Default: all attribute types."`
+	RelationTypes           []string          `json:"relationTypes,omitempty" jsonschema:"Optional. Only include relations of these relation types, given as roles, co-roles, public IDs, qualified names (e.g. 'Table contains Column') or UUIDs. Default: all relation types."`
+	AttributesLimit         int               `json:"attributesLimit,omitempty" jsonschema:"Optional. Maximum number of attributes to return per attribute kind (text, numeric, boolean, date). The maximum value is 1000. Default: 100."`
+	OutgoingRelationsLimit  int               `json:"outgoingRelationsLimit,omitempty" jsonschema:"Optional. Maximum number of outgoing relations to return. The maximum value is 1000. Default: 50."`
+	IncomingRelationsLimit  int               `json:"incomingRelationsLimit,omitempty" jsonschema:"Optional. Maximum number of incoming relations to return. The maximum value is 1000. Default: 50."`
+	All                     bool              `json:"all,omitempty" jsonschema:"Optional. Set to true to page through every selected section until it is complete or reaches maxItemsPerSection, instead of returning a single page. Default: false."`
+	MaxItemsPerSection      int               `json:"maxItemsPerSection,omitempty" jsonschema:"Optional. With all, the maximum number of items to return per section. The maximum value is 10000. Default: 1000."`
 }
 
 type AssetDetailsOutput struct {
-	Asset *clients.Asset `json:"asset,omitempty" jsonschema:"the detailed asset information if found"`
-	Link  string         `json:"link,omitempty" jsonschema:"the link you can navigate to in Collibra to view the asset"`
-	Error string         `json:"error,omitempty" jsonschema:"error message if asset not found or other error occurred"`
-	Found bool           `json:"found" jsonschema:"whether the asset was found"`
+	Asset *clients.Asset     `json:"asset,omitempty" jsonschema:"the detailed asset information if found"`
+	Link  string             `json:"link,omitempty" jsonschema:"the link you can navigate to in Collibra to view the asset"`
+	Error string             `json:"error,omitempty" jsonschema:"error message if asset not found or other error occurred"`
+	Found bool               `json:"found" jsonschema:"whether the asset was found"`
+	Pages []AssetDetailsPage `json:"pages,omitempty" jsonschema:"per returned section, how many items were returned and whether there are more, with the cursor to fetch them"`
 }
 
-func NewAssetDetailsTool(collibraClient *http.Client) *chip.Tool[AssetDetailsInput, AssetDetailsOutput] {
+func NewAssetDetailsTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[AssetDetailsInput, AssetDetailsOutput] {
 	return &chip.Tool[AssetDetailsInput, AssetDetailsOutput]{
 		Name:        "asset_details_get",
-		Description: "Get detailed information about a specific asset by its UUID, including attributes, relations, and metadata. Returns up to 100 attributes per type and supports cursor-based pagination for relations (50 per page).",
-		Handler:     handleAssetDetails(collibraClient),
+		Description: "Get detailed information about a specific asset by its UUID, including attributes, relations, and metadata. Returns up to 100 attributes per kind and 50 relations per direction by default; the response tells per section whether there are more and gives the cursor for the next page. Set all to fetch every page of the selected sections at once, up to a ceiling. Only request the sections, attribute types and relation types needed to answer the question to keep the response small.",
+		Handler:     handleAssetDetails(collibraClient, metamodelCache),
 	}
 }
 
-func handleAssetDetails(collibraClient *http.Client) chip.ToolHandlerFunc[AssetDetailsInput, AssetDetailsOutput] {
+func handleAssetDetails(collibraClient *http.Client, metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[AssetDetailsInput, AssetDetailsOutput] {
 	return func(ctx context.Context, input AssetDetailsInput) (AssetDetailsOutput, error) {
 		assetUUID, err := uuid.Parse(input.AssetID)
 		if err != nil {
@@ -43,13 +55,20 @@ func handleAssetDetails(collibraClient *http.Client) chip.ToolHandlerFunc[AssetD
 			}, nil
 		}
 
-		assets, err := clients.GetAssetSummary(
-			ctx,
-			collibraClient,
-			assetUUID,
-			input.OutgoingRelationsCursor,
-			input.IncomingRelationsCursor,
-		)
+		request, err := buildAssetDetailsRequest(ctx, metamodelCache, assetUUID, input)
+		if err != nil {
+			return AssetDetailsOutput{
+				Error: err.Error(),
+				Found: false,
+			}, nil
+		}
+
+		maxItems := limitOrDefault(input.MaxItemsPerSection, defaultMaxItemsPerSection)
+		if input.All {
+			request.Selection = withLargestPages(request.Selection, min(maxItems, maxAssetDetailsLimit))
+		}
+
+		assets, err := clients.GetAssetSummary(ctx, collibraClient, withLookahead(request))
 		if err != nil {
 			return AssetDetailsOutput{
 				Error: fmt.Sprintf("Failed to retrieve asset details: %s", err.Error()),
@@ -64,15 +83,87 @@ func handleAssetDetails(collibraClient *http.Client) chip.ToolHandlerFunc[AssetD
 			}, nil
 		}
 
+		asset := &assets[0]
+		pages := trimAssetSections(asset, request)
+		if input.All {
+			pages, err = fetchAllAssetSections(ctx, collibraClient, asset, pages, request, maxItems)
+			if err != nil {
+				return AssetDetailsOutput{
+					Error: fmt.Sprintf("Failed to retrieve all asset details: %s", err.Error()),
+					Found: false,
+				}, nil
+			}
+		}
+
 		collibraHost, ok := chip.GetCollibraHost(ctx)
 		if !ok {
 			slog.WarnContext(ctx, "Collibra instance URL unknown, links will be rendered without host")
 		}
 
 		return AssetDetailsOutput{
-			Asset: &assets[0],
+			Asset: asset,
 			Found: true,
 			Link:  fmt.Sprintf("%s/asset/%s", strings.TrimSuffix(collibraHost, "/"), assetUUID),
+			Pages: pages,
 		}, nil
 	}
 }
+
+const (
+	maxAssetDetailsLimit      = 1000
+	defaultMaxItemsPerSection = 1000
+	maxItemsPerSection        = 10000
+)
+
+func buildAssetDetailsRequest(ctx context.Context, metamodelCache *metamodel.Cache, assetUUID uuid.UUID, input AssetDetailsInput) (clients.AssetDetailsRequest, error) {
+	for _, section := range input.Sections {
+		if !slices.Contains(clients.AssetDetailsSections, section) {
+			return clients.AssetDetailsRequest{}, fmt.Errorf("unsupported section '%s', supported sections are: %v", section, clients.AssetDetailsSections)
+		}
+	}
+	for _, limit := range []int{input.AttributesLimit, input.OutgoingRelationsLimit, input.IncomingRelationsLimit} {
+		if limit < 0 || limit > maxAssetDetailsLimit {
+			return clients.AssetDetailsRequest{}, fmt.Errorf("limits must be between 0 and %d, got %d", maxAssetDetailsLimit, limit)
+		}
+	}
+
+	if input.MaxItemsPerSection < 0 || input.MaxItemsPerSection > maxItemsPerSection {
+		return clients.AssetDetailsRequest{}, fmt.Errorf("maxItemsPerSection must be between 0 and %d, got %d", maxItemsPerSection, input.MaxItemsPerSection)
+	}
+
+	cursors := map[string]string{}
+	for section, cursor := range input.Cursors {
+		if findAssetSection(section) == nil {
+			return clients.AssetDetailsRequest{}, fmt.Errorf("unknown cursor section '%s'", section)
+		}
+		cursors[section] = cursor
+	}
+	if input.OutgoingRelationsCursor != "" {
+		cursors[clients.SectionOutgoingRelations] = input.OutgoingRelationsCursor
+	}
+	if input.IncomingRelationsCursor != "" {
+		cursors[clients.SectionIncomingRelations] = input.IncomingRelationsCursor
+	}
+
+	attributeTypeIDs, err := resolveAttributeTypeNames(ctx, metamodelCache, input.AttributeTypes)
+	if err != nil {
+		return clients.AssetDetailsRequest{}, err
+	}
+	relationTypeIDs, err := resolveRelationTypeNames(ctx, metamodelCache, input.RelationTypes)
+	if err != nil {
+		return clients.AssetDetailsRequest{}, err
+	}
+
+	return clients.AssetDetailsRequest{
+		AssetID: assetUUID.String(),
+		Cursors: cursors,
+		Selection: clients.AssetDetailsSelection{
+			Sections:               input.Sections,
+			AttributeTypeIDs:       attributeTypeIDs,
+			RelationTypeIDs:        relationTypeIDs,
+			AttributesLimit:        input.AttributesLimit,
+			OutgoingRelationsLimit: input.OutgoingRelationsLimit,
+			IncomingRelationsLimit: input.IncomingRelationsLimit,
+		},
+	}, nil
+}
@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/google/uuid"
+)
+
+const maxBatchAssetDetails = 20
+
+type AssetDetailsBatchInput struct {
+	Assets []AssetDetailsBatchItem `json:"assets" jsonschema:"Required. The assets to retrieve details for, at most 20, each with its own optional relation cursors, sections, attribute and relation types, and limits."`
+}
+
+// AssetDetailsBatchItem is a single asset of a batch. It has the paging and
+// selection options of AssetDetailsInput, but not all and maxItemsPerSection:
+// a batch returns a single page per asset.
+type AssetDetailsBatchItem struct {
+	AssetID                 string            `json:"assetId" jsonschema:"the UUID of the asset to retrieve details for"`
+	OutgoingRelationsCursor string            `json:"outgoingRelationsCursor,omitempty" jsonschema:"Optional. Cursor to fetch the next page of outgoing relations. Same as cursors.outgoingRelations."`
+	IncomingRelationsCursor string            `json:"incomingRelationsCursor,omitempty" jsonschema:"Optional. Cursor to fetch the next page of incoming relations. Same as cursors.incomingRelations."`
+	Cursors                 map[string]string `json:"cursors,omitempty" jsonschema:"Optional. Cursors to fetch the next page of sections, keyed by section name (stringAttributes, numericAttributes, booleanAttributes, dateAttributes, outgoingRelations, incomingRelations). Use the nextCursor of the section from the pages of the previous response."`
+	Sections                []string          `json:"sections,omitempty" jsonschema:"Optional. The sections to include besides the asset's type, domain and status: attributes, outgoingRelations, incomingRelations. Default: all sections."`
+	AttributeTypes          []string          `json:"attributeTypes,omitempty" jsonschema:"Optional. Only include attributes of these attribute types, given as names (e.g. 'Description') or UUIDs. Default: all attribute types."`
+	RelationTypes           []string          `json:"relationTypes,omitempty" jsonschema:"Optional. Only include relations of these relation types, given as roles, co-roles, public IDs, qualified names (e.g. 'Table contains Column') or UUIDs. Default: all relation types."`
+	AttributesLimit         int               `json:"attributesLimit,omitempty" jsonschema:"Optional. Maximum number of attributes to return per attribute kind (text, numeric, boolean, date). The maximum value is 1000. Default: 100."`
+	OutgoingRelationsLimit  int               `json:"outgoingRelationsLimit,omitempty" jsonschema:"Optional. Maximum number of outgoing relations to return. The maximum value is 1000. Default: 50."`
+	IncomingRelationsLimit  int               `json:"incomingRelationsLimit,omitempty" jsonschema:"Optional. Maximum number of incoming relations to return. The maximum value is 1000. Default: 50."`
+}
+
+func (item AssetDetailsBatchItem) detailsInput() AssetDetailsInput {
+	return AssetDetailsInput{
+		AssetID:                 item.AssetID,
+		OutgoingRelationsCursor: item.OutgoingRelationsCursor,
+		IncomingRelationsCursor: item.IncomingRelationsCursor,
+		Cursors:                 item.Cursors,
+		Sections:                item.Sections,
+		AttributeTypes:          item.AttributeTypes,
+		RelationTypes:           item.RelationTypes,
+		AttributesLimit:         item.AttributesLimit,
+		OutgoingRelationsLimit:  item.OutgoingRelationsLimit,
+		IncomingRelationsLimit:  item.IncomingRelationsLimit,
+	}
+}
+
+type AssetDetailsBatchOutput struct {
+	Results []AssetDetailsBatchResult `json:"results" jsonschema:"The details per requested asset, in the order they were requested"`
+	Error   string                    `json:"error,omitempty" jsonschema:"Error message if the batch as a whole could not be processed"`
+}
+
+type AssetDetailsBatchResult struct {
+	AssetID string             `json:"assetId" jsonschema:"The requested asset ID"`
+	Asset   *clients.Asset     `json:"asset,omitempty" jsonschema:"The detailed asset information if found"`
+	Link    string             `json:"link,omitempty" jsonschema:"The link you can navigate to in Collibra to view the asset"`
+	Found   bool               `json:"found" jsonschema:"Whether the asset was found"`
+	Error   string             `json:"error,omitempty" jsonschema:"Error message if the asset was not found or could not be retrieved"`
+	Pages   []AssetDetailsPage `json:"pages,omitempty" jsonschema:"Per returned section of this asset, how many items were returned and whether there are more, with the cursor to fetch them"`
+}
+
+func NewAssetDetailsBatchTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[AssetDetailsBatchInput, AssetDetailsBatchOutput] {
+	return &chip.Tool[AssetDetailsBatchInput, AssetDetailsBatchOutput]{
+		Name:        "asset_details_batch_get",
+		Description: fmt.Sprintf("Get detailed information about up to %d assets in a single call, including attributes, relations, and metadata. Use it instead of repeated asset_details_get calls, e.g. to compare columns. Not-found assets and errors are reported per asset, and every section is paged per asset with its own cursors.", maxBatchAssetDetails),
+		Handler:     handleAssetDetailsBatch(collibraClient, metamodelCache),
+	}
+}
+
+func handleAssetDetailsBatch(collibraClient *http.Client, metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[AssetDetailsBatchInput, AssetDetailsBatchOutput] {
+	return func(ctx context.Context, input AssetDetailsBatchInput) (AssetDetailsBatchOutput, error) {
+		if len(input.Assets) == 0 {
+			return AssetDetailsBatchOutput{Error: "At least one asset is required"}, nil
+		}
+		if len(input.Assets) > maxBatchAssetDetails {
+			return AssetDetailsBatchOutput{Error: fmt.Sprintf("At most %d assets can be retrieved at once, got %d", maxBatchAssetDetails, len(input.Assets))}, nil
+		}
+
+		results := make([]AssetDetailsBatchResult, len(input.Assets))
+		var requests []clients.AssetDetailsRequest
+		var requestIndexes []int
+		for i, asset := range input.Assets {
+			results[i].AssetID = asset.AssetID
+			assetUUID, err := uuid.Parse(asset.AssetID)
+			if err != nil {
+				results[i].Error = fmt.Sprintf("Invalid asset ID format: %s", err.Error())
+				continue
+			}
+			request, err := buildAssetDetailsRequest(ctx, metamodelCache, assetUUID, asset.detailsInput())
+			if err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+			requests = append(requests, request)
+			requestIndexes = append(requestIndexes, i)
+		}
+		if len(requests) == 0 {
+			return AssetDetailsBatchOutput{Results: results}, nil
+		}
+
+		lookaheadRequests := make([]clients.AssetDetailsRequest, len(requests))
+		for j, request := range requests {
+			lookaheadRequests[j] = withLookahead(request)
+		}
+		response, err := clients.GetAssetDetailsBatch(ctx, collibraClient, lookaheadRequests)
+		if err != nil {
+			for _, i := range requestIndexes {
+				results[i].Error = fmt.Sprintf("Failed to retrieve asset details: %s", err.Error())
+			}
+			return AssetDetailsBatchOutput{Results: results}, nil
+		}
+
+		collibraHost, ok := chip.GetCollibraHost(ctx)
+		if !ok {
+			slog.WarnContext(ctx, "Collibra instance URL unknown, links will be rendered without host")
+		}
+
+		for j, i := range requestIndexes {
+			alias := clients.BatchAssetAlias(j)
+			if errors := response.ErrorsFor(alias); len(errors) > 0 {
+				results[i].Error = fmt.Sprintf("Failed to retrieve asset details: %s", strings.Join(errors, "; "))
+				continue
+			}
+
+			assets := response.Data[alias]
+			if len(assets) == 0 {
+				results[i].Error = "Asset not found"
+				continue
+			}
+
+			asset := assets[0]
+			results[i].Pages = trimAssetSections(&asset, requests[j])
+			results[i].Asset = &asset
+			results[i].Found = true
+			results[i].Link = fmt.Sprintf("%s/asset/%s", strings.TrimSuffix(collibraHost, "/"), asset.ID)
+		}
+
+		return AssetDetailsBatchOutput{Results: results}, nil
+	}
+}
@@ -0,0 +1,119 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/google/uuid"
+)
+
+func TestGetAssetDetailsBatch(t *testing.T) {
+	found, _ := uuid.NewUUID()
+	missing, _ := uuid.NewUUID()
+	failing, _ := uuid.NewUUID()
+
+	var query clients.Request
+	handler := http.NewServeMux()
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.BatchAssetDetailsResponse) {
+		query = request
+		relations := make([]clients.OutgoingRelation, clients.RelationsLimit+1)
+		for i := range relations {
+			relations[i] = clients.OutgoingRelation{ID: fmt.Sprintf("relation-%d", i)}
+		}
+		return http.StatusOK, clients.BatchAssetDetailsResponse{
+			Data: map[string][]clients.Asset{
+				"asset0": {{ID: found.String(), DisplayName: "revenue_eur", OutgoingRelations: relations}},
+				"asset1": {},
+			},
+			Errors: []clients.Error{{Message: "access denied", Path: []any{"asset2"}}},
+		}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewAssetDetailsBatchTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsBatchInput{
+		Assets: []tools.AssetDetailsBatchItem{
+			{AssetID: found.String()},
+			{AssetID: missing.String(), IncomingRelationsCursor: "cursor"},
+			{AssetID: failing.String()},
+			{AssetID: "not-a-uuid"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Results) != 4 {
+		t.Fatalf("Expected a result per asset, got: %+v", output)
+	}
+
+	if query.Variables["asset1IncomingRelationsCursor"] != "cursor" || query.Variables["asset0IncomingRelationsCursor"] != nil {
+		t.Errorf("Expected the cursor to only apply to the second asset, got: %v", query.Variables)
+	}
+	if !strings.Contains(query.Query, "asset2: assets(") {
+		t.Errorf("Expected an aliased query per asset, got: %s", query.Query)
+	}
+
+	first := output.Results[0]
+	if !first.Found || first.Asset.DisplayName != "revenue_eur" {
+		t.Errorf("Expected the first asset to be found, got: %+v", first)
+	}
+	if len(first.Asset.OutgoingRelations) != clients.RelationsLimit {
+		t.Errorf("Expected the outgoing relations to be cut to the page size, got: %d", len(first.Asset.OutgoingRelations))
+	}
+	for _, page := range first.Pages {
+		switch page.Section {
+		case "outgoingRelations":
+			if !page.HasMore || page.NextCursor != fmt.Sprintf("relation-%d", clients.RelationsLimit-1) {
+				t.Errorf("Expected more outgoing relations with a next cursor, got: %+v", page)
+			}
+		default:
+			if page.HasMore || page.NextCursor != "" {
+				t.Errorf("Expected no more items, got: %+v", page)
+			}
+		}
+	}
+	if output.Results[1].Found || output.Results[1].Error != "Asset not found" {
+		t.Errorf("Expected the second asset not to be found, got: %+v", output.Results[1])
+	}
+	if output.Results[2].Found || !strings.Contains(output.Results[2].Error, "access denied") {
+		t.Errorf("Expected an error for the third asset, got: %+v", output.Results[2])
+	}
+	if output.Results[3].Found || !strings.HasPrefix(output.Results[3].Error, "Invalid asset ID format") {
+		t.Errorf("Expected a validation error for the fourth asset, got: %+v", output.Results[3])
+	}
+}
+
+func TestGetAssetDetailsBatch_TooManyAssets(t *testing.T) {
+	assets := make([]tools.AssetDetailsBatchItem, 21)
+	client := &http.Client{}
+	output, err := tools.NewAssetDetailsBatchTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsBatchInput{Assets: assets})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error == "" || len(output.Results) != 0 {
+		t.Fatalf("Expected a batch size error, got: %+v", output)
+	}
+}
+
+func TestGetAssetDetailsBatch_ItemSchema(t *testing.T) {
+	schema, err := jsonschema.For[tools.AssetDetailsBatchInput](nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	item := schema.Properties["assets"].Items
+	if item.Properties["assetId"] == nil {
+		t.Fatalf("Expected the batch item schema to have assetId, got: %v", item.Properties)
+	}
+	for _, property := range []string{"all", "maxItemsPerSection"} {
+		if item.Properties[property] != nil {
+			t.Errorf("Expected the batch item schema not to have %s", property)
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package tools_test
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 	"github.com/collibra/chip/pkg/tools"
 	"github.com/google/uuid"
 )
@@ -30,7 +34,7 @@ func TestGetAssetDetails(t *testing.T) {
 
 	client := newClient(server)
 
-	output, err := tools.NewAssetDetailsTool(client).Handler(t.Context(), tools.AssetDetailsInput{
+	output, err := tools.NewAssetDetailsTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsInput{
 		AssetID: assetId.String(),
 	})
 	if err != nil {
@@ -45,3 +49,248 @@ func TestGetAssetDetails(t *testing.T) {
 		t.Fatalf("Expected answer '%s', got: '%s'", expectedAnswer, output.Asset.DisplayName)
 	}
 }
+
+func TestGetAssetDetailsSelection(t *testing.T) {
+	assetId, _ := uuid.NewUUID()
+	descriptionId, _ := uuid.NewUUID()
+	containsId, _ := uuid.NewUUID()
+
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AttributeTypes: []clients.AttributeTypeDetails{{ID: descriptionId.String(), Name: "Description"}},
+		RelationTypes:  []clients.RelationTypeDetails{{ID: containsId.String(), Role: "contains", CoRole: "is part of"}},
+	})
+	var query clients.Request
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.Response) {
+		query = request
+		return http.StatusOK, clients.Response{
+			Data: &clients.AssetQueryData{Assets: []clients.Asset{{ID: assetId.String(), DisplayName: "orders"}}},
+		}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewAssetDetailsTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:                assetId.String(),
+		Sections:               []string{"attributes", "incomingRelations"},
+		AttributeTypes:         []string{"description"},
+		RelationTypes:          []string{"is part of"},
+		AttributesLimit:        5,
+		IncomingRelationsLimit: 10,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Found {
+		t.Fatalf("Expected the asset to be found, got: %+v", output)
+	}
+
+	if strings.Contains(query.Query, "outgoingRelations") {
+		t.Errorf("Expected outgoing relations to be left out, got: %s", query.Query)
+	}
+	if !strings.Contains(query.Query, "where: { type: { id: { in: $attributeTypeIds } } }") ||
+		!strings.Contains(query.Query, "incomingRelations(order: { id: asc }, where: { type: { id: { in: $relationTypeIds } } }, limit: $incomingRelationsLimit)") {
+		t.Errorf("Expected attributes and relations to be filtered by type, got: %s", query.Query)
+	}
+	// One item more than the limit is requested, to know whether the section has more items.
+	if query.Variables["attributesLimit"] != float64(6) || query.Variables["incomingRelationsLimit"] != float64(11) {
+		t.Errorf("Expected the section limits to be passed, got: %v", query.Variables)
+	}
+	attributeTypeIds, _ := query.Variables["attributeTypeIds"].([]any)
+	relationTypeIds, _ := query.Variables["relationTypeIds"].([]any)
+	if len(attributeTypeIds) != 1 || attributeTypeIds[0] != descriptionId.String() || len(relationTypeIds) != 1 || relationTypeIds[0] != containsId.String() {
+		t.Errorf("Expected the type names to be resolved, got: %v", query.Variables)
+	}
+}
+
+func TestGetAssetDetailsQualifiedRelationTypes(t *testing.T) {
+	assetId, _ := uuid.NewUUID()
+	tableContainsColumnId, _ := uuid.NewUUID()
+	schemaContainsTableId, _ := uuid.NewUUID()
+
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		RelationTypes: []clients.RelationTypeDetails{
+			{
+				ID: tableContainsColumnId.String(), Role: "contains", CoRole: "is part of", PublicId: "ColumnIsPartOfTable",
+				SourceType: clients.NamedResourceReference{Name: "Table"}, TargetType: clients.NamedResourceReference{Name: "Column"},
+			},
+			{
+				ID: schemaContainsTableId.String(), Role: "contains", CoRole: "is part of", PublicId: "TableIsPartOfSchema",
+				SourceType: clients.NamedResourceReference{Name: "Schema"}, TargetType: clients.NamedResourceReference{Name: "Table"},
+			},
+		},
+	})
+	var query clients.Request
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.Response) {
+		query = request
+		return http.StatusOK, clients.Response{
+			Data: &clients.AssetQueryData{Assets: []clients.Asset{{ID: assetId.String(), DisplayName: "orders"}}},
+		}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	tool := tools.NewAssetDetailsTool(client, newMetamodelCache(client))
+	output, err := tool.Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:       assetId.String(),
+		RelationTypes: []string{"table contains column", "Table is part of Schema"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Found {
+		t.Fatalf("Expected the asset to be found, got: %+v", output)
+	}
+	relationTypeIds, _ := query.Variables["relationTypeIds"].([]any)
+	if len(relationTypeIds) != 2 || relationTypeIds[0] != tableContainsColumnId.String() || relationTypeIds[1] != schemaContainsTableId.String() {
+		t.Errorf("Expected the qualified relation types to be resolved, got: %v", query.Variables)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:       assetId.String(),
+		RelationTypes: []string{"TableIsPartOfSchema"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	relationTypeIds, _ = query.Variables["relationTypeIds"].([]any)
+	if !output.Found || len(relationTypeIds) != 1 || relationTypeIds[0] != schemaContainsTableId.String() {
+		t.Errorf("Expected the public ID to be resolved, got: %+v, %v", output, query.Variables)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:       assetId.String(),
+		RelationTypes: []string{"contains"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || !strings.Contains(output.Error, "ambiguous relation type 'contains'") || !strings.Contains(output.Error, "'Table contains Column'") {
+		t.Errorf("Expected an ambiguity error naming the qualified relation types, got: %+v", output)
+	}
+}
+
+func TestGetAssetDetailsUnsupportedSection(t *testing.T) {
+	assetId, _ := uuid.NewUUID()
+	client := &http.Client{}
+	output, err := tools.NewAssetDetailsTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:  assetId.String(),
+		Sections: []string{"lineage"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || !strings.Contains(output.Error, "unsupported section 'lineage'") {
+		t.Fatalf("Expected an unsupported section error, got: %+v", output)
+	}
+}
+
+func TestGetAssetDetailsAll(t *testing.T) {
+	assetId, _ := uuid.NewUUID()
+
+	relations := make([]clients.OutgoingRelation, 25)
+	for i := range relations {
+		relations[i] = clients.OutgoingRelation{ID: fmt.Sprintf("%08d-0000-0000-0000-000000000000", i)}
+	}
+
+	var requests []clients.Request
+	handler := http.NewServeMux()
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.Response) {
+		requests = append(requests, request)
+		start := 0
+		if cursor, ok := request.Variables["outgoingRelationsCursor"].(string); ok {
+			start = slices.IndexFunc(relations, func(relation clients.OutgoingRelation) bool { return relation.ID == cursor }) + 1
+		}
+		end := min(start+int(request.Variables["outgoingRelationsLimit"].(float64)), len(relations))
+		asset := clients.Asset{ID: assetId.String(), OutgoingRelations: relations[start:end]}
+		if len(requests) == 1 {
+			asset.StringAttributes = []clients.StringAttribute{{ID: "a1", Value: "Orders placed online"}}
+		}
+		return http.StatusOK, clients.Response{Data: &clients.AssetQueryData{Assets: []clients.Asset{asset}}}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewAssetDetailsTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:                assetId.String(),
+		Sections:               []string{"attributes", "outgoingRelations"},
+		OutgoingRelationsLimit: 10,
+		All:                    true,
+		MaxItemsPerSection:     22,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Found {
+		t.Fatalf("Expected the asset to be found, got: %+v", output)
+	}
+
+	if len(requests) != 3 {
+		t.Errorf("Expected 3 requests to page the outgoing relations, got: %d", len(requests))
+	}
+	if strings.Contains(requests[1].Query, "stringAttributes") {
+		t.Errorf("Expected complete sections not to be fetched again, got: %s", requests[1].Query)
+	}
+	if len(output.Asset.OutgoingRelations) != 22 || len(output.Asset.StringAttributes) != 1 {
+		t.Errorf("Expected 22 outgoing relations and 1 text attribute, got: %d and %d", len(output.Asset.OutgoingRelations), len(output.Asset.StringAttributes))
+	}
+	for _, page := range output.Pages {
+		switch page.Section {
+		case "outgoingRelations":
+			if page.Returned != 22 || !page.HasMore || page.NextCursor != relations[21].ID {
+				t.Errorf("Expected the outgoing relations to stop at the ceiling, got: %+v", page)
+			}
+		case "stringAttributes":
+			if page.Returned != 1 || page.HasMore {
+				t.Errorf("Expected the text attributes to be complete, got: %+v", page)
+			}
+		}
+	}
+}
+
+func TestGetAssetDetailsAllAtMaximumPageSize(t *testing.T) {
+	assetId, _ := uuid.NewUUID()
+
+	attributes := make([]clients.StringAttribute, 1000)
+	for i := range attributes {
+		attributes[i] = clients.StringAttribute{ID: fmt.Sprintf("%08d-0000-0000-0000-000000000000", i)}
+	}
+
+	var requests []clients.Request
+	handler := http.NewServeMux()
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.Response) {
+		requests = append(requests, request)
+		if request.Variables["attributesLimit"] != float64(1000) {
+			t.Errorf("Expected the attributes limit to stay at the maximum, got: %v", request.Variables["attributesLimit"])
+		}
+		asset := clients.Asset{ID: assetId.String()}
+		if _, ok := request.Variables["stringAttributesCursor"]; !ok {
+			asset.StringAttributes = attributes
+		}
+		return http.StatusOK, clients.Response{Data: &clients.AssetQueryData{Assets: []clients.Asset{asset}}}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewAssetDetailsTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.AssetDetailsInput{
+		AssetID:            assetId.String(),
+		Sections:           []string{"attributes"},
+		AttributesLimit:    1000,
+		All:                true,
+		MaxItemsPerSection: 5000,
+	})
+	if err != nil || output.Error != "" {
+		t.Fatalf("Expected no error, got: %v %s", err, output.Error)
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected a second request to find out whether there are more attributes, got: %d", len(requests))
+	}
+	if page := output.Pages[0]; page.Section != "stringAttributes" || page.Returned != 1000 || page.HasMore {
+		t.Errorf("Expected the text attributes to be complete, got: %+v", page)
+	}
+}
@@ -0,0 +1,186 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+var assetChangeCategories = []string{"attribute", "status", "relation", "responsibility", "asset"}
+
+const (
+	activitiesPageSize   = 1000
+	maxAssetHistoryLimit = 1000
+	// maxScannedActivities bounds the activities looked at in one call when few of them match the change types.
+	maxScannedActivities = 10000
+)
+
+type AssetHistoryInput struct {
+	AssetID     string   `json:"assetId" jsonschema:"Required. The UUID of the asset to get the change history of."`
+	Since       string   `json:"since,omitempty" jsonschema:"Optional. Only return changes made at or after this date (YYYY-MM-DD) or timestamp (RFC 3339)."`
+	Until       string   `json:"until,omitempty" jsonschema:"Optional. Only return changes made before this date (YYYY-MM-DD) or timestamp (RFC 3339)."`
+	ChangeTypes []string `json:"changeTypes,omitempty" jsonschema:"Optional. Only return these kinds of changes: attribute, status, relation, responsibility, asset. Default: all changes."`
+	Limit       int      `json:"limit,omitempty" jsonschema:"Optional. Maximum number of changes to return. The maximum allowed limit is 1000. Default: 100."`
+	Offset      int      `json:"offset,omitempty" jsonschema:"Optional. Index of the first activity to look at (pagination offset). Use the nextOffset of the previous response to get the next changes. Default: 0."`
+}
+
+type AssetHistoryOutput struct {
+	Total      int64         `json:"total" jsonschema:"The total number of activities on the asset in the time range, before filtering on change types"`
+	Changes    []AssetChange `json:"changes" jsonschema:"The changes on the asset, most recent first"`
+	HasMore    bool          `json:"hasMore" jsonschema:"Whether there are activities left to look at for more changes"`
+	NextOffset int           `json:"nextOffset,omitempty" jsonschema:"The offset to pass to get the next changes, when there are more"`
+	Error      string        `json:"error,omitempty" jsonschema:"Error message if the history could not be retrieved"`
+}
+
+type AssetChange struct {
+	Timestamp   string `json:"timestamp" jsonschema:"When the change was made"`
+	Actor       string `json:"actor" jsonschema:"The full name of the user who made the change"`
+	ActorID     string `json:"actorId,omitempty" jsonschema:"The UUID of the user who made the change"`
+	Category    string `json:"category" jsonschema:"The kind of change: attribute, status, relation, responsibility or asset"`
+	Action      string `json:"action" jsonschema:"What happened: ADD, UPDATE or DELETE"`
+	Field       string `json:"field,omitempty" jsonschema:"The attribute type, relation type, role or asset field that changed"`
+	Before      any    `json:"before,omitempty" jsonschema:"The value before the change"`
+	After       any    `json:"after,omitempty" jsonschema:"The value after the change"`
+	Description string `json:"description,omitempty" jsonschema:"The raw description of the change, when it could not be broken down into field and values"`
+}
+
+func NewAssetHistoryTool(collibraClient *http.Client) *chip.Tool[AssetHistoryInput, AssetHistoryOutput] {
+	return &chip.Tool[AssetHistoryInput, AssetHistoryOutput]{
+		Name:        "asset_history",
+		Description: "Get the change history of an asset from the Collibra activity stream: attribute edits, status changes, relation changes and responsibility changes, with the values before and after and who made the change. Filterable by time range and kind of change. Use it to answer audit questions like who changed a definition and when.",
+		Handler:     handleAssetHistory(collibraClient),
+	}
+}
+
+func handleAssetHistory(collibraClient *http.Client) chip.ToolHandlerFunc[AssetHistoryInput, AssetHistoryOutput] {
+	return func(ctx context.Context, input AssetHistoryInput) (AssetHistoryOutput, error) {
+		if input.Limit == 0 {
+			input.Limit = 100
+		}
+		if input.Limit < 1 || input.Limit > maxAssetHistoryLimit {
+			return AssetHistoryOutput{Error: fmt.Sprintf("limit must be between 1 and %d, got %d", maxAssetHistoryLimit, input.Limit)}, nil
+		}
+
+		if _, err := uuid.Parse(input.AssetID); err != nil {
+			return AssetHistoryOutput{Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error())}, nil
+		}
+		for _, changeType := range input.ChangeTypes {
+			if !slices.Contains(assetChangeCategories, changeType) {
+				return AssetHistoryOutput{Error: fmt.Sprintf("Unsupported change type '%s', supported change types are: %v", changeType, assetChangeCategories)}, nil
+			}
+		}
+		since, err := parseSearchDate(input.Since)
+		if err != nil {
+			return AssetHistoryOutput{Error: err.Error()}, nil
+		}
+		until, err := parseSearchDate(input.Until)
+		if err != nil {
+			return AssetHistoryOutput{Error: err.Error()}, nil
+		}
+
+		pageSize := input.Limit
+		if len(input.ChangeTypes) > 0 {
+			pageSize = activitiesPageSize
+		}
+		output := AssetHistoryOutput{Changes: []AssetChange{}}
+		var activities []clients.Activity
+		offset := input.Offset
+		// Changes are filtered after they are fetched, so keep fetching pages until enough of them match.
+		for len(output.Changes) < input.Limit && offset-input.Offset < maxScannedActivities {
+			response, err := clients.FindActivities(ctx, collibraClient, clients.ActivitiesQueryParams{
+				ContextID:      input.AssetID,
+				StartTimestamp: since,
+				EndTimestamp:   until,
+				Limit:          pageSize,
+				Offset:         offset,
+			})
+			if err != nil {
+				return AssetHistoryOutput{Error: fmt.Sprintf("Failed to retrieve activities: %s", err.Error())}, nil
+			}
+			output.Total = response.Total
+
+			for _, activity := range response.Results {
+				offset++
+				change := describeActivity(activity)
+				if len(input.ChangeTypes) > 0 && !slices.Contains(input.ChangeTypes, change.Category) {
+					continue
+				}
+				output.Changes = append(output.Changes, change)
+				activities = append(activities, activity)
+				if len(output.Changes) == input.Limit {
+					break
+				}
+			}
+			if len(response.Results) == 0 || int64(offset) >= response.Total {
+				break
+			}
+		}
+		if int64(offset) < output.Total {
+			output.HasMore = true
+			output.NextOffset = offset
+		}
+
+		actors := newOwnerResolver(collibraClient)
+		references := make([]clients.NamedResourceReference, 0, len(activities))
+		for _, activity := range activities {
+			if activity.UserID != "" {
+				references = append(references, clients.NamedResourceReference{ID: activity.UserID, ResourceType: "User"})
+			}
+		}
+		actors.resolveAll(ctx, references)
+		for i, activity := range activities {
+			if activity.UserID != "" {
+				output.Changes[i].ActorID = activity.UserID
+				output.Changes[i].Actor = actors.resolve(ctx, clients.NamedResourceReference{ID: activity.UserID, ResourceType: "User"}).Name
+			}
+		}
+
+		return output, nil
+	}
+}
+
+// describeActivity breaks an activity down into the kind of change and the values before and after.
+func describeActivity(activity clients.Activity) AssetChange {
+	change := AssetChange{
+		Timestamp: formatTimestamp(activity.Timestamp),
+		Action:    activity.ActivityType,
+		Category:  activityCategory(activity.ResourceDiscriminator),
+	}
+
+	var description clients.ActivityDescription
+	if err := json.Unmarshal([]byte(activity.Description), &description); err != nil || (description.Field == "" && description.Type == "") {
+		change.Description = activity.Description
+		return change
+	}
+
+	change.Field = description.Field
+	if change.Field == "" {
+		change.Field = description.Type
+	}
+	change.Before = description.OldValue
+	change.After = description.NewValue
+	if change.Category == "asset" && strings.EqualFold(change.Field, "status") {
+		change.Category = "status"
+	}
+	return change
+}
+
+func activityCategory(resourceDiscriminator string) string {
+	switch {
+	case strings.HasSuffix(resourceDiscriminator, "Attribute"):
+		return "attribute"
+	case resourceDiscriminator == "Relation":
+		return "relation"
+	case resourceDiscriminator == "Responsibility":
+		return "responsibility"
+	default:
+		return "asset"
+	}
+}
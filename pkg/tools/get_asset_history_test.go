@@ -0,0 +1,166 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestAssetHistory(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	userId := "0f6e1c9a-6b4c-4c29-9a43-1f9d0b6f0a11"
+
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/activities", JsonHandlerOut(func(r *http.Request) (int, clients.ActivityPagedResponse) {
+		if r.URL.Query().Get("contextId") != assetId {
+			t.Errorf("Expected activities of the asset, got: %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("startTimestamp") != "1704067200000" {
+			t.Errorf("Expected the start of the time range to be passed, got: %s", r.URL.RawQuery)
+		}
+		return http.StatusOK, clients.ActivityPagedResponse{
+			Total: 3,
+			Results: []clients.Activity{
+				{
+					ActivityType:          "UPDATE",
+					ResourceDiscriminator: "StringAttribute",
+					UserID:                userId,
+					Description:           `{"field":"Definition","oldValue":"Orders","newValue":"Orders placed online"}`,
+				},
+				{
+					ActivityType:          "UPDATE",
+					ResourceDiscriminator: "Asset",
+					UserID:                userId,
+					Description:           `{"field":"status","oldValue":"Candidate","newValue":"Accepted"}`,
+				},
+				{
+					ActivityType:          "ADD",
+					ResourceDiscriminator: "Relation",
+					UserID:                userId,
+					Description:           "Added relation",
+				},
+			},
+		}
+	}))
+	handler.Handle("/rest/2.0/users/"+userId, JsonHandlerOut(func(r *http.Request) (int, clients.UserDetails) {
+		return http.StatusOK, clients.UserDetails{ID: userId, UserName: "jdoe", FirstName: "John", LastName: "Doe"}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAssetHistoryTool(newClient(server)).Handler(t.Context(), tools.AssetHistoryInput{
+		AssetID:     assetId,
+		Since:       "2024-01-01",
+		ChangeTypes: []string{"attribute", "status"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error != "" {
+		t.Fatalf("Expected no error, got: %s", output.Error)
+	}
+	if len(output.Changes) != 2 {
+		t.Fatalf("Expected the relation change to be filtered out, got: %+v", output.Changes)
+	}
+
+	definition := output.Changes[0]
+	if definition.Category != "attribute" || definition.Field != "Definition" || definition.Before != "Orders" || definition.After != "Orders placed online" {
+		t.Errorf("Unexpected attribute change: %+v", definition)
+	}
+	if definition.Actor != "John Doe" || definition.ActorID != userId {
+		t.Errorf("Expected the actor to be resolved, got: %+v", definition)
+	}
+	status := output.Changes[1]
+	if status.Category != "status" || status.Before != "Candidate" || status.After != "Accepted" {
+		t.Errorf("Unexpected status change: %+v", status)
+	}
+}
+
+func TestAssetHistory_PagesUntilEnoughChangesMatch(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	activities := make([]clients.Activity, 2500)
+	for i := range activities {
+		activities[i] = clients.Activity{ActivityType: "ADD", ResourceDiscriminator: "Relation", Description: fmt.Sprint(i)}
+		if i%1000 == 999 || i == 2100 {
+			activities[i].ResourceDiscriminator = "StringAttribute"
+		}
+	}
+
+	var requests int
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/activities", JsonHandlerOut(func(r *http.Request) (int, clients.ActivityPagedResponse) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		return http.StatusOK, clients.ActivityPagedResponse{
+			Total:   int64(len(activities)),
+			Results: activities[offset:min(offset+limit, len(activities))],
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAssetHistoryTool(newClient(server)).Handler(t.Context(), tools.AssetHistoryInput{
+		AssetID:     assetId,
+		ChangeTypes: []string{"attribute"},
+		Limit:       2,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Changes) != 2 || output.Changes[1].Description != "1999" {
+		t.Fatalf("Expected the attribute changes of the first two pages, got: %+v", output.Changes)
+	}
+	if requests != 2 || !output.HasMore || output.NextOffset != 2000 {
+		t.Errorf("Expected to stop after the second page with more to come, got %d requests and: %+v", requests, output)
+	}
+
+	output, err = tools.NewAssetHistoryTool(newClient(server)).Handler(t.Context(), tools.AssetHistoryInput{
+		AssetID:     assetId,
+		ChangeTypes: []string{"attribute"},
+		Limit:       2,
+		Offset:      output.NextOffset,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Changes) != 1 || output.Changes[0].Description != "2100" || output.HasMore {
+		t.Errorf("Expected the last attribute change and no more, got: %+v", output)
+	}
+}
+
+func TestAssetHistory_UnsupportedChangeType(t *testing.T) {
+	output, err := tools.NewAssetHistoryTool(&http.Client{}).Handler(t.Context(), tools.AssetHistoryInput{
+		AssetID:     "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8",
+		ChangeTypes: []string{"lineage"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error == "" {
+		t.Fatalf("Expected a validation error, got: %+v", output)
+	}
+}
+
+func TestAssetHistory_LimitOutOfRange(t *testing.T) {
+	for _, limit := range []int{-1, 1001} {
+		output, err := tools.NewAssetHistoryTool(&http.Client{}).Handler(t.Context(), tools.AssetHistoryInput{
+			AssetID: "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8",
+			Limit:   limit,
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if !strings.Contains(output.Error, "limit must be between 1 and 1000") {
+			t.Errorf("Expected a limit error for %d, got: %+v", limit, output)
+		}
+	}
+}
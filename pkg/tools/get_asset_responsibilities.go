@@ -0,0 +1,196 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type AssetResponsibilitiesInput struct {
+	AssetID          string `json:"assetId" jsonschema:"Required. The UUID of the asset to retrieve the responsibilities for."`
+	ExcludeInherited bool   `json:"excludeInherited,omitempty" jsonschema:"Optional. Set to true to only return responsibilities assigned directly on the asset, leaving out the ones inherited from its domain and community. Default: false."`
+}
+
+type AssetResponsibilitiesOutput struct {
+	Roles []ResponsibilityRole `json:"roles,omitempty" jsonschema:"The responsibilities of the asset, grouped per role"`
+	Error string               `json:"error,omitempty" jsonschema:"Error message if the responsibilities could not be retrieved"`
+	Found bool                 `json:"found" jsonschema:"Whether responsibilities were retrieved for the asset"`
+}
+
+type ResponsibilityRole struct {
+	RoleID string                `json:"roleId" jsonschema:"The UUID of the role"`
+	Role   string                `json:"role" jsonschema:"The name of the role (e.g., Owner, Steward)"`
+	Owners []ResponsibilityOwner `json:"owners" jsonschema:"The users and user groups that hold this role"`
+}
+
+type ResponsibilityOwner struct {
+	ID            string `json:"id" jsonschema:"The UUID of the user or user group"`
+	Type          string `json:"type" jsonschema:"Either User or UserGroup"`
+	Name          string `json:"name" jsonschema:"The full name of the user or the name of the user group"`
+	UserName      string `json:"userName,omitempty" jsonschema:"The user name, for users"`
+	Email         string `json:"email,omitempty" jsonschema:"The email address, for users"`
+	Inherited     bool   `json:"inherited" jsonschema:"Whether the responsibility is inherited from a domain or community rather than assigned on the asset itself"`
+	InheritedFrom string `json:"inheritedFrom,omitempty" jsonschema:"The type and name of the domain or community the responsibility is inherited from"`
+}
+
+func NewAssetResponsibilitiesTool(collibraClient *http.Client) *chip.Tool[AssetResponsibilitiesInput, AssetResponsibilitiesOutput] {
+	return &chip.Tool[AssetResponsibilitiesInput, AssetResponsibilitiesOutput]{
+		Name:        "asset_responsibilities_get",
+		Description: "Get who is responsible for an asset: the users and user groups per role (e.g., Owner, Steward), including the responsibilities inherited from the asset's domain and community. Use it to route data-access or ownership questions to the right person.",
+		Handler:     handleAssetResponsibilities(collibraClient),
+	}
+}
+
+func handleAssetResponsibilities(collibraClient *http.Client) chip.ToolHandlerFunc[AssetResponsibilitiesInput, AssetResponsibilitiesOutput] {
+	return func(ctx context.Context, input AssetResponsibilitiesInput) (AssetResponsibilitiesOutput, error) {
+		assetUUID, err := uuid.Parse(input.AssetID)
+		if err != nil {
+			return AssetResponsibilitiesOutput{
+				Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error()),
+				Found: false,
+			}, nil
+		}
+
+		responsibilities, err := findAllResponsibilities(ctx, collibraClient, clients.ResponsibilitiesQueryParams{
+			ResourceIDs:      []string{assetUUID.String()},
+			IncludeInherited: !input.ExcludeInherited,
+		})
+		if err != nil {
+			return AssetResponsibilitiesOutput{
+				Error: fmt.Sprintf("Failed to retrieve responsibilities: %s", err.Error()),
+				Found: false,
+			}, nil
+		}
+
+		owners := newOwnerResolver(collibraClient)
+		references := make([]clients.NamedResourceReference, len(responsibilities))
+		for i, responsibility := range responsibilities {
+			references[i] = responsibility.Owner
+		}
+		owners.resolveAll(ctx, references)
+
+		var roles []ResponsibilityRole
+		roleIndex := map[string]int{}
+		for _, responsibility := range responsibilities {
+			owner := owners.resolve(ctx, responsibility.Owner)
+			if responsibility.BaseResource.ID != "" && responsibility.BaseResource.ID != assetUUID.String() {
+				owner.Inherited = true
+				owner.InheritedFrom = describeResource(responsibility.BaseResource)
+			}
+
+			i, ok := roleIndex[responsibility.Role.ID]
+			if !ok {
+				i = len(roles)
+				roleIndex[responsibility.Role.ID] = i
+				roles = append(roles, ResponsibilityRole{RoleID: responsibility.Role.ID, Role: responsibility.Role.Name})
+			}
+			roles[i].Owners = append(roles[i].Owners, owner)
+		}
+
+		return AssetResponsibilitiesOutput{
+			Roles: roles,
+			Found: true,
+		}, nil
+	}
+}
+
+// findAllResponsibilities pages through the responsibilities matching the query until all of them are retrieved.
+func findAllResponsibilities(ctx context.Context, collibraClient *http.Client, params clients.ResponsibilitiesQueryParams) ([]clients.Responsibility, error) {
+	params.Limit = responsibilitiesPageSize
+	var responsibilities []clients.Responsibility
+	for {
+		response, err := clients.FindResponsibilities(ctx, collibraClient, params)
+		if err != nil {
+			return nil, err
+		}
+		responsibilities = append(responsibilities, response.Results...)
+		params.Offset += len(response.Results)
+		if len(response.Results) == 0 || int64(params.Offset) >= response.Total {
+			return responsibilities, nil
+		}
+	}
+}
+
+func describeResource(resource clients.NamedResourceReference) string {
+	if resource.Name == "" {
+		return fmt.Sprintf("%s %s", resource.ResourceType, resource.ID)
+	}
+	return fmt.Sprintf("%s '%s'", resource.ResourceType, resource.Name)
+}
+
+const responsibilitiesPageSize = 1000
+
+// ownerResolver looks up the names of responsibility owners, fetching each user or user group once.
+type ownerResolver struct {
+	client *http.Client
+	mu     sync.Mutex
+	owners map[string]ResponsibilityOwner
+}
+
+func newOwnerResolver(collibraClient *http.Client) *ownerResolver {
+	return &ownerResolver{client: collibraClient, owners: map[string]ResponsibilityOwner{}}
+}
+
+func (r *ownerResolver) resolve(ctx context.Context, reference clients.NamedResourceReference) ResponsibilityOwner {
+	r.mu.Lock()
+	owner, ok := r.owners[reference.ID]
+	r.mu.Unlock()
+	if ok {
+		return owner
+	}
+
+	owner = r.lookup(ctx, reference)
+	r.mu.Lock()
+	r.owners[reference.ID] = owner
+	r.mu.Unlock()
+	return owner
+}
+
+// resolveAll looks up the owners that are not known yet in a single batch of concurrent requests, so that
+// the calls to resolve that follow are answered without waiting on Collibra one owner at a time.
+func (r *ownerResolver) resolveAll(ctx context.Context, references []clients.NamedResourceReference) {
+	seen := map[string]bool{}
+	var unknown []clients.NamedResourceReference
+	r.mu.Lock()
+	for _, reference := range references {
+		if _, ok := r.owners[reference.ID]; !ok && !seen[reference.ID] {
+			seen[reference.ID] = true
+			unknown = append(unknown, reference)
+		}
+	}
+	r.mu.Unlock()
+
+	// Owners not looked up because ctx is done are left to resolve.
+	_ = forEachConcurrently(ctx, len(unknown), func(i int) {
+		r.resolve(ctx, unknown[i])
+	})
+}
+
+func (r *ownerResolver) lookup(ctx context.Context, reference clients.NamedResourceReference) ResponsibilityOwner {
+	owner := ResponsibilityOwner{ID: reference.ID, Type: reference.ResourceType, Name: reference.Name}
+	switch reference.ResourceType {
+	case "User":
+		user, err := clients.GetUser(ctx, r.client, reference.ID)
+		if err != nil {
+			slog.WarnContext(ctx, fmt.Sprintf("Failed to look up user %s: %v", reference.ID, err))
+			break
+		}
+		owner.Name = user.FullName()
+		owner.UserName = user.UserName
+		owner.Email = user.EmailAddress
+	case "UserGroup":
+		userGroup, err := clients.GetUserGroup(ctx, r.client, reference.ID)
+		if err != nil {
+			slog.WarnContext(ctx, fmt.Sprintf("Failed to look up user group %s: %v", reference.ID, err))
+			break
+		}
+		owner.Name = userGroup.Name
+	}
+	return owner
+}
@@ -0,0 +1,135 @@
+package tools_test
+
+import (
+	"cmp"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestGetAssetResponsibilities(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/responsibilities", JsonHandlerOut(func(r *http.Request) (int, clients.ResponsibilityPagedResponse) {
+		if r.URL.Query().Get("resourceIds") != assetId {
+			t.Errorf("Expected responsibilities for asset %s, got: %s", assetId, r.URL.Query().Get("resourceIds"))
+		}
+		if r.URL.Query().Get("includeInherited") != "true" {
+			t.Errorf("Expected inherited responsibilities to be included")
+		}
+		steward := clients.NamedResourceReference{ID: "00000000-0000-0000-0000-000000005016", ResourceType: "Role", Name: "Steward"}
+		return http.StatusOK, clients.ResponsibilityPagedResponse{
+			Total: 2,
+			Results: []clients.Responsibility{
+				{
+					ID:           "r1",
+					Role:         steward,
+					Owner:        clients.NamedResourceReference{ID: "u1", ResourceType: "User"},
+					BaseResource: clients.NamedResourceReference{ID: assetId, ResourceType: "Asset"},
+				},
+				{
+					ID:           "r2",
+					Role:         steward,
+					Owner:        clients.NamedResourceReference{ID: "g1", ResourceType: "UserGroup"},
+					BaseResource: clients.NamedResourceReference{ID: "d1", ResourceType: "Domain", Name: "Sales DWH"},
+				},
+			},
+		}
+	}))
+	handler.Handle("/rest/2.0/users/u1", JsonHandlerOut(func(r *http.Request) (int, clients.UserDetails) {
+		return http.StatusOK, clients.UserDetails{ID: "u1", UserName: "jdoe", FirstName: "John", LastName: "Doe", EmailAddress: "jdoe@example.com"}
+	}))
+	handler.Handle("/rest/2.0/userGroups/g1", JsonHandlerOut(func(r *http.Request) (int, clients.UserGroupDetails) {
+		return http.StatusOK, clients.UserGroupDetails{ID: "g1", Name: "Data Stewards"}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewAssetResponsibilitiesTool(client).Handler(t.Context(), tools.AssetResponsibilitiesInput{
+		AssetID: assetId,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !output.Found {
+		t.Fatalf("Expected responsibilities to be found, got error: %s", output.Error)
+	}
+	if len(output.Roles) != 1 || output.Roles[0].Role != "Steward" {
+		t.Fatalf("Expected a single Steward role, got: %+v", output.Roles)
+	}
+
+	owners := output.Roles[0].Owners
+	if len(owners) != 2 {
+		t.Fatalf("Expected 2 owners, got: %d", len(owners))
+	}
+	if owners[0].Name != "John Doe" || owners[0].Email != "jdoe@example.com" || owners[0].Inherited {
+		t.Errorf("Unexpected direct owner: %+v", owners[0])
+	}
+	if owners[1].Name != "Data Stewards" || !owners[1].Inherited || owners[1].InheritedFrom != "Domain 'Sales DWH'" {
+		t.Errorf("Unexpected inherited owner: %+v", owners[1])
+	}
+}
+
+func TestGetAssetResponsibilities_Pages(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	owner := clients.NamedResourceReference{ID: "u1", ResourceType: "User"}
+	pages := map[string][]clients.Responsibility{
+		"0": {
+			{ID: "r1", Role: clients.NamedResourceReference{ID: "role1", Name: "Owner"}, Owner: owner},
+			{ID: "r2", Role: clients.NamedResourceReference{ID: "role2", Name: "Steward"}, Owner: owner},
+		},
+		"2": {
+			{ID: "r3", Role: clients.NamedResourceReference{ID: "role3", Name: "Custodian"}, Owner: owner},
+		},
+	}
+	var userLookups atomic.Int32
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/responsibilities", JsonHandlerOut(func(r *http.Request) (int, clients.ResponsibilityPagedResponse) {
+		if r.URL.Query().Get("limit") != "1000" {
+			t.Errorf("Expected pages of 1000 responsibilities, got: %s", r.URL.Query().Get("limit"))
+		}
+		return http.StatusOK, clients.ResponsibilityPagedResponse{Total: 3, Results: pages[cmp.Or(r.URL.Query().Get("offset"), "0")]}
+	}))
+	handler.Handle("/rest/2.0/users/u1", JsonHandlerOut(func(r *http.Request) (int, clients.UserDetails) {
+		userLookups.Add(1)
+		return http.StatusOK, clients.UserDetails{ID: "u1", FirstName: "John", LastName: "Doe"}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAssetResponsibilitiesTool(newClient(server)).Handler(t.Context(), tools.AssetResponsibilitiesInput{
+		AssetID: assetId,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Roles) != 3 {
+		t.Fatalf("Expected the roles of both pages, got: %+v", output.Roles)
+	}
+	if output.Roles[2].Owners[0].Name != "John Doe" {
+		t.Errorf("Unexpected owner: %+v", output.Roles[2].Owners[0])
+	}
+	if userLookups.Load() != 1 {
+		t.Errorf("Expected the user to be looked up once, got: %d", userLookups.Load())
+	}
+}
+
+func TestGetAssetResponsibilities_InvalidAssetID(t *testing.T) {
+	output, err := tools.NewAssetResponsibilitiesTool(&http.Client{}).Handler(t.Context(), tools.AssetResponsibilitiesInput{
+		AssetID: "not-a-uuid",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || output.Error == "" {
+		t.Fatalf("Expected an invalid asset ID error, got: %+v", output)
+	}
+}
@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type AssetTagsInput struct {
+	AssetID string `json:"assetId" jsonschema:"Required. The UUID of the asset to get the tags of."`
+}
+
+type AssetTagsOutput struct {
+	Tags  []string `json:"tags" jsonschema:"The names of the tags on the asset"`
+	Error string   `json:"error,omitempty" jsonschema:"Error message if the tags could not be retrieved"`
+	Found bool     `json:"found" jsonschema:"Whether the tags of the asset were retrieved"`
+}
+
+func NewAssetTagsTool(collibraClient *http.Client) *chip.Tool[AssetTagsInput, AssetTagsOutput] {
+	return &chip.Tool[AssetTagsInput, AssetTagsOutput]{
+		Name:        "asset_tags_get",
+		Description: "Get the tags on an asset (e.g., PII-reviewed, deprecated, golden-source).",
+		Handler:     handleAssetTags(collibraClient),
+	}
+}
+
+func handleAssetTags(collibraClient *http.Client) chip.ToolHandlerFunc[AssetTagsInput, AssetTagsOutput] {
+	return func(ctx context.Context, input AssetTagsInput) (AssetTagsOutput, error) {
+		if _, err := uuid.Parse(input.AssetID); err != nil {
+			return AssetTagsOutput{Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error()), Found: false}, nil
+		}
+
+		tags, err := clients.GetAssetTags(ctx, collibraClient, input.AssetID)
+		if err != nil {
+			return AssetTagsOutput{Error: fmt.Sprintf("Failed to retrieve tags: %s", err.Error()), Found: false}, nil
+		}
+
+		return AssetTagsOutput{Tags: tagNames(tags), Found: true}, nil
+	}
+}
+
+func tagNames(tags []clients.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
@@ -0,0 +1,44 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestAssetTags(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+
+	handler := http.NewServeMux()
+	handler.Handle("GET /rest/2.0/assets/"+assetId+"/tags", JsonHandlerOut(func(r *http.Request) (int, []clients.Tag) {
+		return http.StatusOK, []clients.Tag{{ID: "t1", Name: "PII-reviewed"}, {ID: "t2", Name: "golden-source"}}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewAssetTagsTool(newClient(server)).Handler(t.Context(), tools.AssetTagsInput{AssetID: assetId})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Found {
+		t.Fatalf("Expected tags to be found, got: %+v", output)
+	}
+	if !slices.Equal(output.Tags, []string{"PII-reviewed", "golden-source"}) {
+		t.Errorf("Unexpected tags: %v", output.Tags)
+	}
+}
+
+func TestAssetTags_InvalidAssetID(t *testing.T) {
+	output, err := tools.NewAssetTagsTool(&http.Client{}).Handler(t.Context(), tools.AssetTagsInput{AssetID: "not-a-uuid"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || output.Error == "" {
+		t.Fatalf("Expected a validation error, got: %+v", output)
+	}
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/google/uuid"
+)
+
+type GetGlossaryTermInput struct {
+	Name string `json:"name" jsonschema:"Required. The name or UUID of the business term."`
+}
+
+type GetGlossaryTermOutput struct {
+	Term       *GlossaryTerm           `json:"term,omitempty" jsonschema:"The business term with its definition, acronyms, synonyms and related terms"`
+	Candidates []GlossaryTermReference `json:"candidates,omitempty" jsonschema:"The business terms with this name, when several glossaries define it. Get one of them by UUID."`
+	Found      bool                    `json:"found" jsonschema:"Whether a single business term was found"`
+	Error      string                  `json:"error,omitempty" jsonschema:"Error message if the term could not be retrieved"`
+}
+
+func NewGetGlossaryTermTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[GetGlossaryTermInput, GetGlossaryTermOutput] {
+	return &chip.Tool[GetGlossaryTermInput, GetGlossaryTermOutput]{
+		Name:        "glossary_term_get",
+		Description: "Get a business term from the glossary by its exact name or UUID, with its definition, acronyms, synonyms and related terms.",
+		Handler:     handleGetGlossaryTerm(collibraClient, metamodelCache),
+	}
+}
+
+func handleGetGlossaryTerm(collibraClient *http.Client, metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[GetGlossaryTermInput, GetGlossaryTermOutput] {
+	return func(ctx context.Context, input GetGlossaryTermInput) (GetGlossaryTermOutput, error) {
+		name := strings.TrimSpace(input.Name)
+		if name == "" {
+			return GetGlossaryTermOutput{Error: "Name is required"}, nil
+		}
+
+		gm, err := loadGlossaryMetamodel(ctx, metamodelCache)
+		if err != nil {
+			return GetGlossaryTermOutput{Error: err.Error()}, nil
+		}
+
+		termID := name
+		if _, err := uuid.Parse(name); err != nil {
+			terms, err := findGlossaryTerms(ctx, collibraClient, gm, name)
+			if err != nil {
+				return GetGlossaryTermOutput{Error: fmt.Sprintf("Failed to find business term: %s", err.Error())}, nil
+			}
+			switch len(terms) {
+			case 0:
+				return GetGlossaryTermOutput{Error: fmt.Sprintf("No business term named '%s' exists", name)}, nil
+			case 1:
+				termID = terms[0].ID
+			default:
+				candidates := make([]GlossaryTermReference, len(terms))
+				for i, term := range terms {
+					candidates[i] = GlossaryTermReference{ID: term.ID, Name: term.Name, Domain: term.Domain.Name}
+				}
+				return GetGlossaryTermOutput{Candidates: candidates}, nil
+			}
+		}
+
+		term, err := getGlossaryTerm(ctx, collibraClient, gm, termID)
+		if err != nil {
+			return GetGlossaryTermOutput{Error: fmt.Sprintf("Failed to retrieve business term: %s", err.Error())}, nil
+		}
+		return GetGlossaryTermOutput{Term: term, Found: true}, nil
+	}
+}
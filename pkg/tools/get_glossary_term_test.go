@@ -0,0 +1,57 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestGetGlossaryTerm(t *testing.T) {
+	glossary := newFakeGlossary()
+	glossary.addTerm("Customer", "Sales Glossary", "A party that buys goods or services.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewGetGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.GetGlossaryTermInput{Name: "customer"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Found || output.Term == nil {
+		t.Fatalf("Expected the term to be found, got: %+v", output)
+	}
+	if output.Term.Definition != "A party that buys goods or services." || output.Term.Domain != "Sales Glossary" {
+		t.Errorf("Unexpected term: %+v", output.Term)
+	}
+}
+
+func TestGetGlossaryTerm_SeveralGlossaries(t *testing.T) {
+	glossary := newFakeGlossary()
+	glossary.addTerm("Customer", "Sales Glossary", "A party that buys goods or services.")
+	glossary.addTerm("Customer", "Support Glossary", "A party with a support contract.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewGetGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.GetGlossaryTermInput{Name: "Customer"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || len(output.Candidates) != 2 {
+		t.Fatalf("Expected two candidates, got: %+v", output)
+	}
+}
+
+func TestGetGlossaryTerm_NotFound(t *testing.T) {
+	server := newFakeGlossary().server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewGetGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.GetGlossaryTermInput{Name: "Customer"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Found || output.Error == "" {
+		t.Errorf("Expected an error, got: %+v", output)
+	}
+}
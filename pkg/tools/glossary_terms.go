@@ -0,0 +1,309 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+)
+
+const (
+	businessTermTypeName    = "Business Term"
+	definitionAttributeName = "Definition"
+	acronymAttributeName    = "Acronym"
+	synonymRelationRole     = "is synonym of"
+	relatedTermRelationRole = "relates to"
+	candidateStatusName     = "Candidate"
+)
+
+type GlossaryTerm struct {
+	ID           string                  `json:"id" jsonschema:"The UUID of the business term"`
+	Name         string                  `json:"name" jsonschema:"The name of the business term"`
+	DomainID     string                  `json:"domainId" jsonschema:"The UUID of the glossary domain of the term"`
+	Domain       string                  `json:"domain" jsonschema:"The name of the glossary domain of the term"`
+	Status       string                  `json:"status,omitempty" jsonschema:"The status of the term, e.g. Candidate or Accepted"`
+	Definition   string                  `json:"definition,omitempty" jsonschema:"The definition of the term"`
+	Acronyms     []string                `json:"acronyms,omitempty" jsonschema:"The acronyms of the term"`
+	Synonyms     []GlossaryTermReference `json:"synonyms,omitempty" jsonschema:"The business terms that are synonyms of the term"`
+	RelatedTerms []GlossaryTermReference `json:"relatedTerms,omitempty" jsonschema:"The business terms related to the term"`
+	Link         string                  `json:"link" jsonschema:"The link to view the term in Collibra"`
+
+	definitionAttributeID string
+}
+
+type GlossaryTermReference struct {
+	ID     string `json:"id" jsonschema:"The UUID of the business term"`
+	Name   string `json:"name" jsonschema:"The name of the business term"`
+	Domain string `json:"domain,omitempty" jsonschema:"The name of the glossary domain of the business term"`
+}
+
+// GlossaryCheck is a finding of the quality checks run before a term is written. Findings with severity error block the write.
+type GlossaryCheck struct {
+	Check    string `json:"check" jsonschema:"The check: missing_definition, circular_definition or duplicate_term"`
+	Severity string `json:"severity" jsonschema:"error when the finding blocks the write, warning otherwise"`
+	Message  string `json:"message" jsonschema:"What was found"`
+}
+
+// glossaryMetamodel holds the IDs of the metamodel resources the glossary tools work with.
+type glossaryMetamodel struct {
+	termTypeID        string
+	definitionTypeID  string
+	acronymTypeID     string
+	synonymTypeID     string
+	relatedTypeID     string
+	candidateStatusID string
+}
+
+func loadGlossaryMetamodel(ctx context.Context, metamodelCache *metamodel.Cache) (glossaryMetamodel, error) {
+	mm, err := metamodelCache.Get(ctx)
+	if err != nil {
+		return glossaryMetamodel{}, err
+	}
+
+	var gm glossaryMetamodel
+	var missing []string
+	if assetTypes := mm.AssetTypesByName(businessTermTypeName); len(assetTypes) > 0 {
+		gm.termTypeID = assetTypes[0].ID
+	} else {
+		missing = append(missing, fmt.Sprintf("asset type '%s'", businessTermTypeName))
+	}
+	if attributeTypes := mm.AttributeTypesByName(definitionAttributeName); len(attributeTypes) > 0 {
+		gm.definitionTypeID = attributeTypes[0].ID
+	} else {
+		missing = append(missing, fmt.Sprintf("attribute type '%s'", definitionAttributeName))
+	}
+	if attributeTypes := mm.AttributeTypesByName(acronymAttributeName); len(attributeTypes) > 0 {
+		gm.acronymTypeID = attributeTypes[0].ID
+	} else {
+		missing = append(missing, fmt.Sprintf("attribute type '%s'", acronymAttributeName))
+	}
+	if relationTypes := mm.RelationTypesByRole(synonymRelationRole); len(relationTypes) > 0 {
+		gm.synonymTypeID = relationTypes[0].ID
+	} else {
+		missing = append(missing, fmt.Sprintf("relation type '%s'", synonymRelationRole))
+	}
+	if relationTypes := mm.RelationTypesByRole(relatedTermRelationRole); len(relationTypes) > 0 {
+		gm.relatedTypeID = relationTypes[0].ID
+	} else {
+		missing = append(missing, fmt.Sprintf("relation type '%s'", relatedTermRelationRole))
+	}
+	if statuses := mm.StatusesByName(candidateStatusName); len(statuses) > 0 {
+		gm.candidateStatusID = statuses[0].ID
+	} else {
+		missing = append(missing, fmt.Sprintf("status '%s'", candidateStatusName))
+	}
+
+	if len(missing) > 0 {
+		return glossaryMetamodel{}, fmt.Errorf("the metamodel is missing the %s", strings.Join(missing, ", "))
+	}
+	return gm, nil
+}
+
+func findGlossaryTerms(ctx context.Context, collibraClient *http.Client, gm glossaryMetamodel, name string) ([]clients.AssetResource, error) {
+	response, err := clients.FindAssets(ctx, collibraClient, clients.AssetsQueryParams{
+		Name:          name,
+		NameMatchMode: "EXACT",
+		TypeIDs:       []string{gm.termTypeID},
+		Limit:         100,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
+func resolveGlossaryTermNames(ctx context.Context, collibraClient *http.Client, gm glossaryMetamodel, values []string) ([]string, error) {
+	return resolveNames("business term", values, func(value string) ([]namedResource, error) {
+		terms, err := findGlossaryTerms(ctx, collibraClient, gm, value)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]namedResource, len(terms))
+		for i, term := range terms {
+			resources[i] = namedResource{ID: term.ID, Name: term.Name, Label: term.Domain.Name}
+		}
+		return resources, nil
+	})
+}
+
+func getGlossaryTerm(ctx context.Context, collibraClient *http.Client, gm glossaryMetamodel, termID string) (*GlossaryTerm, error) {
+	asset, err := clients.GetAsset(ctx, collibraClient, termID)
+	if err != nil {
+		return nil, err
+	}
+
+	collibraHost, ok := chip.GetCollibraHost(ctx)
+	if !ok {
+		slog.WarnContext(ctx, "Collibra instance URL unknown, links will be rendered without host")
+	}
+	term := &GlossaryTerm{
+		ID:       asset.ID,
+		Name:     asset.Name,
+		DomainID: asset.Domain.ID,
+		Domain:   asset.Domain.Name,
+		Link:     fmt.Sprintf("%s/asset/%s", strings.TrimSuffix(collibraHost, "/"), asset.ID),
+	}
+	if asset.Status != nil {
+		term.Status = asset.Status.Name
+	}
+
+	attributes, err := clients.FindAttributes(ctx, collibraClient, clients.AttributesQueryParams{
+		AssetID: termID,
+		TypeIDs: []string{gm.definitionTypeID, gm.acronymTypeID},
+		Limit:   100,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, attribute := range attributes.Results {
+		value := htmlToText(fmt.Sprint(attribute.Value))
+		switch attribute.Type.ID {
+		case gm.definitionTypeID:
+			term.Definition = value
+			term.definitionAttributeID = attribute.ID
+		case gm.acronymTypeID:
+			term.Acronyms = append(term.Acronyms, value)
+		}
+	}
+
+	if term.Synonyms, err = findRelatedTerms(ctx, collibraClient, termID, gm.synonymTypeID); err != nil {
+		return nil, err
+	}
+	if term.RelatedTerms, err = findRelatedTerms(ctx, collibraClient, termID, gm.relatedTypeID); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+// findRelatedTerms returns the terms on the other end of the relations of the type, in either direction.
+func findRelatedTerms(ctx context.Context, collibraClient *http.Client, termID string, relationTypeID string) ([]GlossaryTermReference, error) {
+	var terms []GlossaryTermReference
+	for _, params := range []clients.RelationsQueryParams{
+		{SourceID: termID, RelationTypeID: relationTypeID, Limit: 100},
+		{TargetID: termID, RelationTypeID: relationTypeID, Limit: 100},
+	} {
+		relations, err := clients.FindRelations(ctx, collibraClient, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, relation := range relations.Results {
+			other := relation.Target
+			if params.TargetID != "" {
+				other = relation.Source
+			}
+			terms = append(terms, GlossaryTermReference{ID: other.ID, Name: other.Name})
+		}
+	}
+	return terms, nil
+}
+
+// glossaryTermDraft is a business term as it will be after a create or update. CurrentName is empty on create.
+type glossaryTermDraft struct {
+	ID                string
+	DomainID          string
+	Name              string
+	CurrentName       string
+	Definition        string
+	ReferencedTermIDs []string
+}
+
+// checkGlossaryTerm looks for missing and circular definitions and, when the term gets a new name, for terms of the
+// same name in its domain.
+func checkGlossaryTerm(ctx context.Context, collibraClient *http.Client, gm glossaryMetamodel, draft glossaryTermDraft) ([]GlossaryCheck, error) {
+	checks := []GlossaryCheck{}
+
+	definition := strings.TrimSpace(draft.Definition)
+	switch {
+	case definition == "":
+		checks = append(checks, GlossaryCheck{Check: "missing_definition", Severity: "error", Message: fmt.Sprintf("The term '%s' has no definition", draft.Name)})
+	case len(strings.Fields(definition)) < 3 || strings.EqualFold(definition, draft.Name):
+		checks = append(checks, GlossaryCheck{Check: "missing_definition", Severity: "warning", Message: fmt.Sprintf("The definition of '%s' is too short to be meaningful", draft.Name)})
+	}
+
+	if definition != "" && mentionsTerm(definition, draft.Name) {
+		checks = append(checks, GlossaryCheck{Check: "circular_definition", Severity: "error", Message: fmt.Sprintf("The definition of '%s' uses the term itself", draft.Name)})
+	}
+	for _, termID := range draft.ReferencedTermIDs {
+		term, err := getGlossaryTerm(ctx, collibraClient, gm, termID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve business term %s: %w", termID, err)
+		}
+		if mentionsTerm(definition, term.Name) && mentionsTerm(term.Definition, draft.Name) {
+			checks = append(checks, GlossaryCheck{
+				Check:    "circular_definition",
+				Severity: "error",
+				Message:  fmt.Sprintf("The definitions of '%s' and '%s' are defined in terms of each other", draft.Name, term.Name),
+			})
+		}
+	}
+
+	if draft.Name == draft.CurrentName {
+		return checks, nil
+	}
+	duplicates, err := clients.FindAssets(ctx, collibraClient, clients.AssetsQueryParams{
+		Name:          draft.Name,
+		NameMatchMode: "EXACT",
+		TypeIDs:       []string{gm.termTypeID},
+		DomainID:      draft.DomainID,
+		Limit:         100,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look for duplicate terms: %w", err)
+	}
+	for _, duplicate := range duplicates.Results {
+		if duplicate.ID == draft.ID {
+			continue
+		}
+		checks = append(checks, GlossaryCheck{
+			Check:    "duplicate_term",
+			Severity: "error",
+			Message:  fmt.Sprintf("A business term named '%s' already exists in domain '%s' (id: %s)", duplicate.Name, duplicate.Domain.Name, duplicate.ID),
+		})
+	}
+
+	return checks, nil
+}
+
+func hasBlockingCheck(checks []GlossaryCheck) bool {
+	for _, check := range checks {
+		if check.Severity == "error" {
+			return true
+		}
+	}
+	return false
+}
+
+// mentionsTerm tells whether the text uses the term as a whole word, ignoring case.
+func mentionsTerm(text string, term string) bool {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return false
+	}
+	text = strings.ToLower(text)
+	for start := 0; ; {
+		i := strings.Index(text[start:], term)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(term)
+		before, _ := utf8.DecodeLastRuneInString(text[:i])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		start = i + size
+	}
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
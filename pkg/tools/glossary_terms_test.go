@@ -0,0 +1,210 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+)
+
+const (
+	glossaryTermTypeID       = "00000000-0000-0000-0000-000000011001"
+	glossaryDefinitionTypeID = "00000000-0000-0000-0000-000000003206"
+	glossaryAcronymTypeID    = "00000000-0000-0000-0000-000000000278"
+	glossarySynonymTypeID    = "00000000-0000-0000-0000-000000007001"
+	glossaryRelatedTypeID    = "00000000-0000-0000-0000-000000007002"
+	glossaryCandidateID      = "00000000-0000-0000-0000-000000005008"
+	glossaryDomainID         = "00000000-0000-0000-0000-00000000d001"
+	supportGlossaryDomainID  = "00000000-0000-0000-0000-00000000d002"
+)
+
+// glossaryDomainIDs are the IDs of the glossary domains the fake glossary knows by name.
+var glossaryDomainIDs = map[string]string{
+	"Sales Glossary":   glossaryDomainID,
+	"Support Glossary": supportGlossaryDomainID,
+}
+
+// fakeGlossary is an in-memory Collibra holding business terms, their attributes and relations.
+type fakeGlossary struct {
+	mu         sync.Mutex
+	assets     map[string]*clients.AssetResource
+	attributes []*clients.AttributeResource
+	relations  []*clients.RelationResource
+	nextID     int
+}
+
+func newFakeGlossary() *fakeGlossary {
+	return &fakeGlossary{assets: map[string]*clients.AssetResource{}}
+}
+
+func (g *fakeGlossary) newID() string {
+	g.nextID++
+	return fmt.Sprintf("aaaaaaaa-0000-0000-0000-%012d", g.nextID)
+}
+
+func (g *fakeGlossary) addTerm(name string, domain string, definition string) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	id := g.newID()
+	g.assets[id] = &clients.AssetResource{
+		ID:     id,
+		Name:   name,
+		Type:   clients.NamedResourceReference{ID: glossaryTermTypeID},
+		Domain: clients.NamedResourceReference{ID: glossaryDomainIDs[domain], Name: domain},
+	}
+	if definition != "" {
+		g.attributes = append(g.attributes, &clients.AttributeResource{
+			ID:    g.newID(),
+			Type:  clients.NamedResourceReference{ID: glossaryDefinitionTypeID},
+			Asset: clients.NamedResourceReference{ID: id},
+			Value: definition,
+		})
+	}
+	return id
+}
+
+func (g *fakeGlossary) reference(id string) clients.NamedResourceReference {
+	if asset, ok := g.assets[id]; ok {
+		return clients.NamedResourceReference{ID: id, Name: asset.Name}
+	}
+	return clients.NamedResourceReference{ID: id}
+}
+
+func (g *fakeGlossary) server() *httptest.Server {
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AssetTypes: []clients.AssetTypeDetails{{ID: glossaryTermTypeID, Name: "Business Term"}},
+		AttributeTypes: []clients.AttributeTypeDetails{
+			{ID: glossaryDefinitionTypeID, Name: "Definition"},
+			{ID: glossaryAcronymTypeID, Name: "Acronym"},
+		},
+		RelationTypes: []clients.RelationTypeDetails{
+			{ID: glossarySynonymTypeID, Role: "is synonym of", CoRole: "is synonym of"},
+			{ID: glossaryRelatedTypeID, Role: "relates to", CoRole: "is related to"},
+		},
+		Statuses: []clients.StatusDetails{{ID: glossaryCandidateID, Name: "Candidate"}},
+	})
+
+	handler.Handle("GET /rest/2.0/assets", JsonHandlerOut(func(r *http.Request) (int, clients.AssetPagedResponse) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		response := clients.AssetPagedResponse{Results: []clients.AssetResource{}}
+		domainID := r.URL.Query().Get("domainId")
+		for _, asset := range g.assets {
+			if strings.EqualFold(asset.Name, r.URL.Query().Get("name")) && (domainID == "" || asset.Domain.ID == domainID) {
+				response.Results = append(response.Results, *asset)
+			}
+		}
+		response.Total = int64(len(response.Results))
+		return http.StatusOK, response
+	}))
+	handler.Handle("POST /rest/2.0/assets", JsonHandlerInOut(func(r *http.Request, request clients.AddAssetRequest) (int, clients.AssetResource) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		asset := &clients.AssetResource{
+			ID:     g.newID(),
+			Name:   request.Name,
+			Type:   clients.NamedResourceReference{ID: request.TypeID},
+			Domain: clients.NamedResourceReference{ID: request.DomainID, Name: "Glossary"},
+			Status: &clients.NamedResourceReference{ID: request.StatusID, Name: "Candidate"},
+		}
+		g.assets[asset.ID] = asset
+		return http.StatusCreated, *asset
+	}))
+	handler.Handle("GET /rest/2.0/assets/{id}", JsonHandlerOut(func(r *http.Request) (int, *clients.AssetResource) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		asset, ok := g.assets[r.PathValue("id")]
+		if !ok {
+			return http.StatusNotFound, nil
+		}
+		return http.StatusOK, asset
+	}))
+	handler.Handle("PATCH /rest/2.0/assets/{id}", JsonHandlerInOut(func(r *http.Request, request clients.ChangeAssetRequest) (int, *clients.AssetResource) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		asset, ok := g.assets[r.PathValue("id")]
+		if !ok {
+			return http.StatusNotFound, nil
+		}
+		if request.Name != "" {
+			asset.Name = request.Name
+		}
+		return http.StatusOK, asset
+	}))
+
+	handler.Handle("GET /rest/2.0/attributes", JsonHandlerOut(func(r *http.Request) (int, clients.AttributePagedResponse) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		response := clients.AttributePagedResponse{Results: []clients.AttributeResource{}}
+		for _, attribute := range g.attributes {
+			if attribute.Asset.ID == r.URL.Query().Get("assetId") {
+				response.Results = append(response.Results, *attribute)
+			}
+		}
+		return http.StatusOK, response
+	}))
+	handler.Handle("POST /rest/2.0/attributes", JsonHandlerInOut(func(r *http.Request, request clients.AddAttributeRequest) (int, clients.AttributeResource) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		attribute := &clients.AttributeResource{
+			ID:    g.newID(),
+			Type:  clients.NamedResourceReference{ID: request.TypeID},
+			Asset: clients.NamedResourceReference{ID: request.AssetID},
+			Value: request.Value,
+		}
+		g.attributes = append(g.attributes, attribute)
+		return http.StatusCreated, *attribute
+	}))
+	handler.Handle("PATCH /rest/2.0/attributes/{id}", JsonHandlerInOut(func(r *http.Request, request clients.ChangeAttributeRequest) (int, *clients.AttributeResource) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		for _, attribute := range g.attributes {
+			if attribute.ID == r.PathValue("id") {
+				attribute.Value = request.Value
+				return http.StatusOK, attribute
+			}
+		}
+		return http.StatusNotFound, nil
+	}))
+
+	handler.Handle("GET /rest/2.0/relations", JsonHandlerOut(func(r *http.Request) (int, clients.RelationPagedResponse) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		query := r.URL.Query()
+		response := clients.RelationPagedResponse{Results: []clients.RelationResource{}}
+		for _, relation := range g.relations {
+			if relation.Type.ID != query.Get("relationTypeId") {
+				continue
+			}
+			if (query.Get("sourceId") != "" && relation.Source.ID == query.Get("sourceId")) ||
+				(query.Get("targetId") != "" && relation.Target.ID == query.Get("targetId")) {
+				response.Results = append(response.Results, clients.RelationResource{
+					ID:     relation.ID,
+					Type:   relation.Type,
+					Source: g.reference(relation.Source.ID),
+					Target: g.reference(relation.Target.ID),
+				})
+			}
+		}
+		return http.StatusOK, response
+	}))
+	handler.Handle("POST /rest/2.0/relations", JsonHandlerInOut(func(r *http.Request, request clients.AddRelationRequest) (int, clients.RelationResource) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		relation := &clients.RelationResource{
+			ID:     g.newID(),
+			Type:   clients.NamedResourceReference{ID: request.TypeID},
+			Source: clients.NamedResourceReference{ID: request.SourceID},
+			Target: clients.NamedResourceReference{ID: request.TargetID},
+		}
+		g.relations = append(g.relations, relation)
+		return http.StatusCreated, *relation
+	}))
+
+	return httptest.NewServer(handler)
+}
@@ -2,11 +2,16 @@ package tools
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/collibra/chip/pkg/chip"
 	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 )
 
 type SearchKeywordInput struct {
@@ -16,53 +21,124 @@ type SearchKeywordInput struct {
 	ResourceTypeFilters []string `json:"resourceTypeFilters,omitempty" jsonschema:"Optional. Restrict search results to the specified resource types across all of their fields. Supported values: Asset, Domain, Community, User, UserGroup. Default: all resource types are searched"`
 	CommunityFilter     []string `json:"communityFilter,omitempty" jsonschema:"Optional. Filter by resources within the specified community UUIDs."`
 	DomainFilter        []string `json:"domainFilter,omitempty" jsonschema:"Optional. Filter by resources within the specified domain UUIDs."`
-	DomainTypeFilter    []string `json:"domainTypeFilter,omitempty" jsonschema:"Optional. Filter by resources with the specified domain type UUIDs."`
-	AssetTypeFilter     []string `json:"assetTypeFilter,omitempty" jsonschema:"Optional. Filter by resources with the specified asset type UUIDs."`
-	StatusFilter        []string `json:"statusFilter,omitempty" jsonschema:"Optional. Filter by resources with the specified status UUIDs."`
-	CreatedByFilter     []string `json:"createdByFilter,omitempty" jsonschema:"Optional. Filter by resources created by the specified user UUIDs."`
+	DomainTypeFilter    []string `json:"domainTypeFilter,omitempty" jsonschema:"Optional. Filter by resources with the specified domain types, given as names (e.g. 'Glossary') or UUIDs."`
+	AssetTypeFilter     []string `json:"assetTypeFilter,omitempty" jsonschema:"Optional. Filter by resources with the specified asset types, given as names (e.g. 'Business Term', 'Table') or UUIDs."`
+	StatusFilter        []string `json:"statusFilter,omitempty" jsonschema:"Optional. Filter by resources with the specified statuses, given as names (e.g. 'Accepted') or UUIDs."`
+	CreatedByFilter     []string `json:"createdByFilter,omitempty" jsonschema:"Optional. Filter by resources created by the specified users, given as user names, email addresses, full names or UUIDs."`
+	TagFilter           []string `json:"tagFilter,omitempty" jsonschema:"Optional. Filter by resources tagged with the specified tag names."`
+	Fields              []string `json:"fields,omitempty" jsonschema:"Optional. Only match the query in these asset fields: name, displayName, comments, tags, dataClassification, or attribute types given by name (e.g. 'Description', 'Definition') or UUID. Restricts the search to assets. Default: all fields."`
+	ExactPhrase         bool     `json:"exactPhrase,omitempty" jsonschema:"Optional. Set to true to match the query as an exact phrase instead of a wildcard keyword search. Default: false."`
+	ExcludeKeywords     []string `json:"excludeKeywords,omitempty" jsonschema:"Optional. Leave out results matching any of these keywords or phrases."`
+	SortBy              string   `json:"sortBy,omitempty" jsonschema:"Optional. How to sort the results: relevance, name or lastModified. Default: relevance."`
+	SortOrder           string   `json:"sortOrder,omitempty" jsonschema:"Optional. The sort direction when sorting by name or lastModified: asc or desc. Default: asc for name, desc for lastModified."`
+	CreatedAfter        string   `json:"createdAfter,omitempty" jsonschema:"Optional. Only return resources created at or after this date (YYYY-MM-DD) or timestamp (RFC 3339)."`
+	CreatedBefore       string   `json:"createdBefore,omitempty" jsonschema:"Optional. Only return resources created before this date (YYYY-MM-DD) or timestamp (RFC 3339)."`
+	ModifiedAfter       string   `json:"modifiedAfter,omitempty" jsonschema:"Optional. Only return resources last modified at or after this date (YYYY-MM-DD) or timestamp (RFC 3339)."`
+	ModifiedBefore      string   `json:"modifiedBefore,omitempty" jsonschema:"Optional. Only return resources last modified before this date (YYYY-MM-DD) or timestamp (RFC 3339)."`
+	Enrich              bool     `json:"enrich,omitempty" jsonschema:"Optional. Set to true to add the asset type, domain, community, status and a link to each asset result, fetched in a single extra query. Default: false."`
+	Facets              []string `json:"facets,omitempty" jsonschema:"Optional. Fields to return bucket counts for across all matching results, to narrow down a broad search. Supported values: assetType, domain, community, status."`
+	FacetLimit          int      `json:"facetLimit,omitempty" jsonschema:"Optional. Maximum number of buckets to return per facet. Default: 10."`
 }
 
 type SearchKeywordOutput struct {
 	Total   int                     `json:"total" jsonschema:"The total number of results available matching the search criteria"`
 	Results []SearchKeywordResource `json:"results" jsonschema:"The list of search results"`
+	Facets  []SearchKeywordFacet    `json:"facets,omitempty" jsonschema:"The bucket counts of the requested facets"`
+}
+
+type SearchKeywordFacet struct {
+	Field   string                     `json:"field" jsonschema:"The faceted field (e.g., assetType, domain)"`
+	Buckets []SearchKeywordFacetBucket `json:"buckets" jsonschema:"The values of the field with their number of matching results"`
+}
+
+type SearchKeywordFacetBucket struct {
+	ID    string `json:"id,omitempty" jsonschema:"The UUID of the value, usable in the corresponding filter"`
+	Name  string `json:"name" jsonschema:"The name of the value"`
+	Count int    `json:"count" jsonschema:"The number of matching results with this value"`
+}
+
+type SearchKeywordHighlight struct {
+	Field    string   `json:"field" jsonschema:"The field the query matched in (e.g., name, comments, a description attribute)"`
+	Snippets []string `json:"snippets" jsonschema:"Fragments of the field around the match"`
 }
 
 type SearchKeywordResource struct {
-	ResourceType   string `json:"resourceType" jsonschema:"The type of the resource (e.g., Asset, Domain, Community, User, UserGroup)"`
-	ID             string `json:"id" jsonschema:"The unique identifier of the resource"`
-	CreatedBy      string `json:"createdBy" jsonschema:"The user who created the resource"`
-	CreatedOn      string `json:"createdOn" jsonschema:"The timestamp when the resource was created (human-readable format)"`
-	LastModifiedOn string `json:"lastModifiedOn" jsonschema:"The timestamp when the resource was last modified (human-readable format)"`
-	Name           string `json:"name" jsonschema:"The name of the resource"`
+	ResourceType   string                   `json:"resourceType" jsonschema:"The type of the resource (e.g., Asset, Domain, Community, User, UserGroup)"`
+	ID             string                   `json:"id" jsonschema:"The unique identifier of the resource"`
+	CreatedBy      string                   `json:"createdBy" jsonschema:"The user who created the resource"`
+	CreatedOn      string                   `json:"createdOn" jsonschema:"The timestamp when the resource was created (human-readable format)"`
+	LastModifiedOn string                   `json:"lastModifiedOn" jsonschema:"The timestamp when the resource was last modified (human-readable format)"`
+	Name           string                   `json:"name" jsonschema:"The name of the resource"`
+	Highlights     []SearchKeywordHighlight `json:"highlights,omitempty" jsonschema:"Snippets of the fields where the query matched"`
+	AssetType      string                   `json:"assetType,omitempty" jsonschema:"The asset type, for enriched asset results"`
+	Domain         string                   `json:"domain,omitempty" jsonschema:"The name of the domain of the asset, for enriched asset results"`
+	Community      string                   `json:"community,omitempty" jsonschema:"The name of the community of the asset, for enriched asset results"`
+	Status         string                   `json:"status,omitempty" jsonschema:"The status of the asset, for enriched asset results"`
+	Link           string                   `json:"link,omitempty" jsonschema:"The link to view the asset in Collibra, for enriched asset results"`
 }
 
-func NewSearchKeywordTool(collibraClient *http.Client) *chip.Tool[SearchKeywordInput, SearchKeywordOutput] {
+func NewSearchKeywordTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[SearchKeywordInput, SearchKeywordOutput] {
 	return &chip.Tool[SearchKeywordInput, SearchKeywordOutput]{
 		Name:        "asset_keyword_search",
-		Description: "Perform a wildcard keyword search for assets in the Collibra knowledge graph. Supports filtering by resource type, community, domain, asset type, status, and creator.",
-		Handler:     handleSearchKeyword(collibraClient),
+		Description: "Perform a wildcard keyword search for assets in the Collibra knowledge graph. Supports filtering by resource type, community, domain, asset type, status, creator, and tag. Asset type, domain type, status and creator filters accept names as well as UUIDs; an ambiguous name returns an error listing the candidates. The query can be scoped to specific fields or attribute types, matched as an exact phrase, combined with excluded keywords, restricted to a created or modified date range, and sorted by relevance, name or last modification. Set enrich to get the asset type, domain, community, status and link of each asset result without calling asset_details_get per hit. Results include highlighted snippets of where the query matched, and facets can be requested to get result counts per asset type, domain, community or status in the same call.",
+		Handler:     handleSearchKeyword(collibraClient, metamodelCache, clients.NewUserCache(collibraClient)),
 	}
 }
 
-func handleSearchKeyword(collibraClient *http.Client) chip.ToolHandlerFunc[SearchKeywordInput, SearchKeywordOutput] {
+func handleSearchKeyword(collibraClient *http.Client, metamodelCache *metamodel.Cache, userCache *clients.UserCache) chip.ToolHandlerFunc[SearchKeywordInput, SearchKeywordOutput] {
 	return func(ctx context.Context, input SearchKeywordInput) (SearchKeywordOutput, error) {
 		if input.Limit == 0 {
 			input.Limit = 50
 		}
 
+		if err := resolveSearchFilterNames(ctx, metamodelCache, userCache, &input); err != nil {
+			return SearchKeywordOutput{}, err
+		}
+
+		aggregations, err := buildSearchAggregations(input)
+		if err != nil {
+			return SearchKeywordOutput{}, err
+		}
+
 		filters := buildSearchFilters(input)
 
-		searchResponse, err := clients.SearchKeyword(ctx, collibraClient, input.Query, input.ResourceTypeFilters, filters, input.Limit, input.Offset)
+		searchRequest := clients.CreateSearchRequest(input.Query, input.ResourceTypeFilters, filters, input.Limit, input.Offset)
+		searchRequest.Aggregations = aggregations
+		if err := applySearchQueryOptions(ctx, metamodelCache, input, &searchRequest); err != nil {
+			return SearchKeywordOutput{}, err
+		}
+
+		searchResponse, err := clients.SearchKeyword(ctx, collibraClient, searchRequest)
 		if err != nil {
 			return SearchKeywordOutput{}, err
 		}
 
 		output := mapSearchResponseToOutput(searchResponse)
+		if input.Enrich {
+			enrichSearchResults(ctx, collibraClient, output.Results)
+		}
 
 		return output, nil
 	}
 }
 
+func resolveSearchFilterNames(ctx context.Context, metamodelCache *metamodel.Cache, userCache *clients.UserCache, input *SearchKeywordInput) error {
+	var err error
+	if input.AssetTypeFilter, err = resolveAssetTypeNames(ctx, metamodelCache, input.AssetTypeFilter); err != nil {
+		return err
+	}
+	if input.StatusFilter, err = resolveStatusNames(ctx, metamodelCache, input.StatusFilter); err != nil {
+		return err
+	}
+	if input.DomainTypeFilter, err = resolveDomainTypeNames(ctx, metamodelCache, input.DomainTypeFilter); err != nil {
+		return err
+	}
+	if input.CreatedByFilter, err = resolveUserNames(ctx, userCache, input.CreatedByFilter); err != nil {
+		return err
+	}
+	return nil
+}
+
 func buildSearchFilters(input SearchKeywordInput) []clients.SearchFilter {
 	var searchFilters []clients.SearchFilter
 
@@ -108,9 +184,196 @@ func buildSearchFilters(input SearchKeywordInput) []clients.SearchFilter {
 		})
 	}
 
+	if tags := cleanTagNames(input.TagFilter); len(tags) > 0 {
+		searchFilters = append(searchFilters, clients.SearchFilter{
+			Field:  "tags",
+			Values: tags,
+		})
+	}
+
 	return searchFilters
 }
 
+var assetSearchFields = []string{"name", "displayName", "comments", "tags", "dataClassification"}
+
+// applySearchQueryOptions narrows the search request to the requested fields, phrase, exclusions, date range and sort order.
+func applySearchQueryOptions(ctx context.Context, metamodelCache *metamodel.Cache, input SearchKeywordInput, searchRequest *clients.SearchRequest) error {
+	searchRequest.Keywords = buildSearchKeywords(input)
+
+	if len(input.Fields) > 0 {
+		fields, err := resolveAssetSearchFields(ctx, metamodelCache, input.Fields)
+		if err != nil {
+			return err
+		}
+		searchInFields := []clients.SearchField{{ResourceType: "Asset", Fields: fields}}
+		for _, searchInField := range searchRequest.SearchInFields {
+			if searchInField.ResourceType != "Asset" {
+				searchInFields = append(searchInFields, searchInField)
+			}
+		}
+		searchRequest.SearchInFields = searchInFields
+	}
+
+	for _, dateRange := range []struct {
+		field, after, before string
+	}{
+		{"createdOn", input.CreatedAfter, input.CreatedBefore},
+		{"lastModifiedOn", input.ModifiedAfter, input.ModifiedBefore},
+	} {
+		if dateRange.after == "" && dateRange.before == "" {
+			continue
+		}
+		rangeFilter := clients.SearchRangeFilter{Field: dateRange.field}
+		var err error
+		if rangeFilter.From, err = parseSearchDate(dateRange.after); err != nil {
+			return err
+		}
+		if rangeFilter.To, err = parseSearchDate(dateRange.before); err != nil {
+			return err
+		}
+		searchRequest.RangeFilters = append(searchRequest.RangeFilters, rangeFilter)
+	}
+
+	switch input.SortBy {
+	case "", "relevance":
+	case "name":
+		searchRequest.SortField = "name"
+		searchRequest.SortOrder = "asc"
+	case "lastModified":
+		searchRequest.SortField = "lastModifiedOn"
+		searchRequest.SortOrder = "desc"
+	default:
+		return fmt.Errorf("unsupported sortBy '%s', supported values are: relevance, name, lastModified", input.SortBy)
+	}
+	if searchRequest.SortField != "" && input.SortOrder != "" {
+		if input.SortOrder != "asc" && input.SortOrder != "desc" {
+			return fmt.Errorf("unsupported sortOrder '%s', supported values are: asc, desc", input.SortOrder)
+		}
+		searchRequest.SortOrder = input.SortOrder
+	}
+
+	return nil
+}
+
+func buildSearchKeywords(input SearchKeywordInput) string {
+	keywords := "*" + input.Query + "*"
+	if input.ExactPhrase {
+		keywords = quoteSearchPhrase(input.Query)
+	}
+	for _, exclusion := range input.ExcludeKeywords {
+		exclusion = strings.TrimSpace(exclusion)
+		if exclusion == "" {
+			continue
+		}
+		if strings.ContainsAny(exclusion, " \t") {
+			exclusion = quoteSearchPhrase(exclusion)
+		}
+		keywords += " NOT " + exclusion
+	}
+	return keywords
+}
+
+func quoteSearchPhrase(phrase string) string {
+	return `"` + strings.ReplaceAll(strings.TrimSpace(phrase), `"`, "") + `"`
+}
+
+// resolveAssetSearchFields keeps the built-in asset fields and resolves the other values to attribute type UUIDs.
+func resolveAssetSearchFields(ctx context.Context, metamodelCache *metamodel.Cache, values []string) ([]string, error) {
+	var fields, attributeTypes []string
+	for _, value := range values {
+		if i := slices.IndexFunc(assetSearchFields, func(field string) bool { return strings.EqualFold(field, value) }); i >= 0 {
+			fields = append(fields, assetSearchFields[i])
+			continue
+		}
+		attributeTypes = append(attributeTypes, value)
+	}
+
+	attributeTypeIDs, err := resolveAttributeTypeNames(ctx, metamodelCache, attributeTypes)
+	if err != nil {
+		return nil, err
+	}
+	return append(fields, attributeTypeIDs...), nil
+}
+
+func parseSearchDate(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid date '%s', use YYYY-MM-DD or an RFC 3339 timestamp", value)
+}
+
+var supportedSearchFacets = []string{"assetType", "domain", "community", "status"}
+
+func buildSearchAggregations(input SearchKeywordInput) ([]clients.SearchAggregationField, error) {
+	limit := input.FacetLimit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var aggregations []clients.SearchAggregationField
+	for _, facet := range input.Facets {
+		if !slices.Contains(supportedSearchFacets, facet) {
+			return nil, fmt.Errorf("unsupported facet '%s', supported facets are: %v", facet, supportedSearchFacets)
+		}
+		aggregations = append(aggregations, clients.SearchAggregationField{Field: facet, Limit: limit})
+	}
+	return aggregations, nil
+}
+
+// enrichSearchResults adds the type, domain, community, status and link to the asset results in one GraphQL query.
+// The search results are still returned when the enrichment fails.
+func enrichSearchResults(ctx context.Context, collibraClient *http.Client, results []SearchKeywordResource) {
+	var assetIDs []string
+	for _, result := range results {
+		if result.ResourceType == "Asset" {
+			assetIDs = append(assetIDs, result.ID)
+		}
+	}
+	if len(assetIDs) == 0 {
+		return
+	}
+
+	assets, err := clients.GetAssetsOverview(ctx, collibraClient, assetIDs)
+	if err != nil {
+		slog.WarnContext(ctx, fmt.Sprintf("Failed to enrich search results: %v", err))
+		return
+	}
+	assetsByID := make(map[string]clients.Asset, len(assets))
+	for _, asset := range assets {
+		assetsByID[asset.ID] = asset
+	}
+
+	collibraHost, ok := chip.GetCollibraHost(ctx)
+	if !ok {
+		slog.WarnContext(ctx, "Collibra instance URL unknown, links will be rendered without host")
+	}
+
+	for i := range results {
+		asset, ok := assetsByID[results[i].ID]
+		if results[i].ResourceType != "Asset" || !ok {
+			continue
+		}
+		if asset.Type != nil {
+			results[i].AssetType = asset.Type.Name
+		}
+		if asset.Domain != nil {
+			results[i].Domain = asset.Domain.Name
+			if asset.Domain.Parent != nil {
+				results[i].Community = asset.Domain.Parent.Name
+			}
+		}
+		if asset.Status != nil {
+			results[i].Status = asset.Status.Name
+		}
+		results[i].Link = fmt.Sprintf("%s/asset/%s", strings.TrimSuffix(collibraHost, "/"), asset.ID)
+	}
+}
+
 func formatTimestamp(milliseconds int64) string {
 	seconds := milliseconds / 1000
 	t := time.Unix(seconds, 0)
@@ -128,10 +391,30 @@ func mapSearchResponseToOutput(searchResponse *clients.SearchResponse) SearchKey
 			LastModifiedOn: formatTimestamp(result.Resource.LastModifiedOn),
 			Name:           result.Resource.Name,
 		}
+		for _, highlight := range result.Highlights {
+			resources[i].Highlights = append(resources[i].Highlights, SearchKeywordHighlight{
+				Field:    highlight.Field,
+				Snippets: highlight.Values,
+			})
+		}
+	}
+
+	var facets []SearchKeywordFacet
+	for _, aggregation := range searchResponse.Aggregations {
+		facet := SearchKeywordFacet{Field: aggregation.Field, Buckets: []SearchKeywordFacetBucket{}}
+		for _, value := range aggregation.Values {
+			facet.Buckets = append(facet.Buckets, SearchKeywordFacetBucket{
+				ID:    value.ID,
+				Name:  value.Name,
+				Count: value.Count,
+			})
+		}
+		facets = append(facets, facet)
 	}
 
 	return SearchKeywordOutput{
 		Total:   searchResponse.Total,
 		Results: resources,
+		Facets:  facets,
 	}
 }
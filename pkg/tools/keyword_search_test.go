@@ -3,9 +3,11 @@ package tools_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 	"github.com/collibra/chip/pkg/tools"
 	"github.com/google/uuid"
 )
@@ -32,7 +34,7 @@ func TestKeywordSearch(t *testing.T) {
 	defer server.Close()
 
 	client := newClient(server)
-	output, err := tools.NewSearchKeywordTool(client).Handler(t.Context(), tools.SearchKeywordInput{
+	output, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
 		Query: "revenue",
 	})
 	if err != nil {
@@ -48,3 +50,294 @@ func TestKeywordSearch(t *testing.T) {
 		t.Fatalf("Expected answer '%s', got: '%s'", expectedAnswer, asset.Name)
 	}
 }
+
+func TestKeywordSearchTagFilter(t *testing.T) {
+	var filters []clients.SearchFilter
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		filters = request.Filters
+		return http.StatusOK, clients.SearchResponse{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	_, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:     "revenue",
+		TagFilter: []string{"golden-source"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for _, filter := range filters {
+		if filter.Field == "tags" && len(filter.Values) == 1 && filter.Values[0] == "golden-source" {
+			return
+		}
+	}
+	t.Fatalf("Expected a tags filter, got: %+v", filters)
+}
+
+func TestKeywordSearchFacetsAndHighlights(t *testing.T) {
+	tableTypeId, _ := uuid.NewUUID()
+	var aggregations []clients.SearchAggregationField
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		aggregations = request.Aggregations
+		return http.StatusOK, clients.SearchResponse{
+			Total: 1,
+			Results: []clients.SearchResult{
+				{
+					Resource: clients.SearchResource{ResourceType: "Asset", ID: "a1", Name: "revenue_eur"},
+					Highlights: []clients.SearchHighlight{
+						{Field: "name", Values: []string{"<b>revenue</b>_eur"}},
+					},
+				},
+			},
+			Aggregations: []clients.SearchAggregation{
+				{Field: "assetType", Values: []clients.SearchAggregationValue{{ID: tableTypeId.String(), Name: "Column", Count: 42}}},
+			},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:  "revenue",
+		Facets: []string{"assetType"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(aggregations) != 1 || aggregations[0].Field != "assetType" || aggregations[0].Limit != 10 {
+		t.Errorf("Expected an assetType aggregation to be requested, got: %+v", aggregations)
+	}
+	if len(output.Facets) != 1 || len(output.Facets[0].Buckets) != 1 || output.Facets[0].Buckets[0].Count != 42 {
+		t.Fatalf("Expected the assetType facet, got: %+v", output.Facets)
+	}
+	if output.Facets[0].Buckets[0].ID != tableTypeId.String() {
+		t.Errorf("Expected the bucket ID to be returned, got: %+v", output.Facets[0].Buckets[0])
+	}
+	highlights := output.Results[0].Highlights
+	if len(highlights) != 1 || highlights[0].Field != "name" || highlights[0].Snippets[0] != "<b>revenue</b>_eur" {
+		t.Errorf("Expected the name highlight, got: %+v", highlights)
+	}
+}
+
+func TestKeywordSearchUnsupportedFacet(t *testing.T) {
+	client := &http.Client{}
+	_, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:  "revenue",
+		Facets: []string{"owner"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported facet 'owner'") {
+		t.Fatalf("Expected an unsupported facet error, got: %v", err)
+	}
+}
+
+func TestKeywordSearchResolvesFilterNames(t *testing.T) {
+	tableTypeId, _ := uuid.NewUUID()
+	accepted, _ := uuid.NewUUID()
+	userId, _ := uuid.NewUUID()
+	domainTypeId, _ := uuid.NewUUID()
+
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AssetTypes: []clients.AssetTypeDetails{{ID: tableTypeId.String(), Name: "Table", PublicId: "Table"}},
+		Statuses:   []clients.StatusDetails{{ID: accepted.String(), Name: "Accepted"}},
+	})
+	handler.Handle("/rest/2.0/users", JsonHandlerOut(func(httpRequest *http.Request) (int, clients.UserPagedResponse) {
+		if httpRequest.URL.Query().Get("name") != "jdoe" {
+			t.Errorf("Expected users to be searched by name 'jdoe', got: '%s'", httpRequest.URL.Query().Get("name"))
+		}
+		return http.StatusOK, clients.UserPagedResponse{
+			Total:   1,
+			Results: []clients.UserDetails{{ID: userId.String(), UserName: "jdoe", FirstName: "John", LastName: "Doe"}},
+		}
+	}))
+	var filters []clients.SearchFilter
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		filters = request.Filters
+		return http.StatusOK, clients.SearchResponse{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	_, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:            "orders",
+		AssetTypeFilter:  []string{"table"},
+		StatusFilter:     []string{"Accepted"},
+		CreatedByFilter:  []string{"jdoe"},
+		DomainTypeFilter: []string{domainTypeId.String()},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := map[string]string{
+		"assetType":  tableTypeId.String(),
+		"status":     accepted.String(),
+		"createdBy":  userId.String(),
+		"domainType": domainTypeId.String(),
+	}
+	if len(filters) != len(expected) {
+		t.Fatalf("Expected %d filters, got: %v", len(expected), filters)
+	}
+	for _, filter := range filters {
+		if len(filter.Values) != 1 || filter.Values[0] != expected[filter.Field] {
+			t.Errorf("Expected filter '%s' to be %s, got: %v", filter.Field, expected[filter.Field], filter.Values)
+		}
+	}
+}
+
+func TestKeywordSearchAmbiguousFilterName(t *testing.T) {
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AssetTypes: []clients.AssetTypeDetails{
+			{ID: "00000000-0000-0000-0000-000000000001", Name: "Report"},
+			{ID: "00000000-0000-0000-0000-000000000002", Name: "Report"},
+		},
+	})
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		t.Error("Search should not be called when a filter name is ambiguous")
+		return http.StatusOK, clients.SearchResponse{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	_, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:           "sales",
+		AssetTypeFilter: []string{"Report"},
+	})
+	if err == nil {
+		t.Fatal("Expected an error for an ambiguous asset type name")
+	}
+	for _, candidate := range []string{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"} {
+		if !strings.Contains(err.Error(), candidate) {
+			t.Errorf("Expected error to list candidate %s, got: %v", candidate, err)
+		}
+	}
+}
+
+func TestKeywordSearchFieldScopedQuery(t *testing.T) {
+	descriptionId, _ := uuid.NewUUID()
+
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AttributeTypes: []clients.AttributeTypeDetails{{ID: descriptionId.String(), Name: "Description"}},
+	})
+	var searchRequest clients.SearchRequest
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		searchRequest = request
+		return http.StatusOK, clients.SearchResponse{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	_, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:           "net revenue",
+		Fields:          []string{"Name", "description"},
+		ExactPhrase:     true,
+		ExcludeKeywords: []string{"forecast", "test data"},
+		SortBy:          "lastModified",
+		ModifiedAfter:   "2024-01-01",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expectedKeywords := `"net revenue" NOT forecast NOT "test data"`
+	if searchRequest.Keywords != expectedKeywords {
+		t.Errorf("Expected keywords '%s', got: '%s'", expectedKeywords, searchRequest.Keywords)
+	}
+	if len(searchRequest.SearchInFields) != 1 || searchRequest.SearchInFields[0].ResourceType != "Asset" ||
+		strings.Join(searchRequest.SearchInFields[0].Fields, ",") != "name,"+descriptionId.String() {
+		t.Errorf("Expected the search to be scoped to the asset name and description, got: %+v", searchRequest.SearchInFields)
+	}
+	if searchRequest.SortField != "lastModifiedOn" || searchRequest.SortOrder != "desc" {
+		t.Errorf("Expected to sort by last modification, got: '%s' '%s'", searchRequest.SortField, searchRequest.SortOrder)
+	}
+	if len(searchRequest.RangeFilters) != 1 || searchRequest.RangeFilters[0].Field != "lastModifiedOn" ||
+		searchRequest.RangeFilters[0].From != 1704067200000 || searchRequest.RangeFilters[0].To != 0 {
+		t.Errorf("Expected a last modified range filter, got: %+v", searchRequest.RangeFilters)
+	}
+}
+
+func TestKeywordSearchInvalidDate(t *testing.T) {
+	client := &http.Client{}
+	_, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:        "revenue",
+		CreatedAfter: "last week",
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid date 'last week'") {
+		t.Fatalf("Expected an invalid date error, got: %v", err)
+	}
+}
+
+func TestKeywordSearchEnrichesAssetResults(t *testing.T) {
+	assetId, _ := uuid.NewUUID()
+	domainId, _ := uuid.NewUUID()
+
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		return http.StatusOK, clients.SearchResponse{
+			Total: 2,
+			Results: []clients.SearchResult{
+				{Resource: clients.SearchResource{ResourceType: "Asset", ID: assetId.String(), Name: "revenue_eur"}},
+				{Resource: clients.SearchResource{ResourceType: "Domain", ID: domainId.String(), Name: "Finance"}},
+			},
+		}
+	}))
+	var requestedIds []any
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.Response) {
+		requestedIds, _ = request.Variables["assetIds"].([]any)
+		return http.StatusOK, clients.Response{
+			Data: &clients.AssetQueryData{
+				Assets: []clients.Asset{
+					{
+						ID:     assetId.String(),
+						Type:   &clients.AssetType{Name: "Column"},
+						Domain: &clients.Domain{ID: domainId.String(), Name: "Finance", Parent: &clients.Community{Name: "Corporate"}},
+						Status: &clients.Status{Name: "Accepted"},
+					},
+				},
+			},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewSearchKeywordTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.SearchKeywordInput{
+		Query:  "revenue",
+		Enrich: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(requestedIds) != 1 || requestedIds[0] != assetId.String() {
+		t.Errorf("Expected only the asset result to be enriched, got: %v", requestedIds)
+	}
+	asset := output.Results[0]
+	if asset.AssetType != "Column" || asset.Domain != "Finance" || asset.Community != "Corporate" || asset.Status != "Accepted" {
+		t.Errorf("Expected the asset result to be enriched, got: %+v", asset)
+	}
+	if !strings.HasSuffix(asset.Link, "/asset/"+assetId.String()) {
+		t.Errorf("Expected a link to the asset, got: '%s'", asset.Link)
+	}
+	if output.Results[1].AssetType != "" || output.Results[1].Link != "" {
+		t.Errorf("Expected the domain result not to be enriched, got: %+v", output.Results[1])
+	}
+}
@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type ListAssetCommentsInput struct {
+	AssetID string `json:"assetId" jsonschema:"Required. The UUID of the asset to list the comments of."`
+	Limit   int    `json:"limit,omitempty" jsonschema:"Optional. Maximum number of comments, including replies, to retrieve. The maximum allowed limit is 1000. Default: 100."`
+	Offset  int    `json:"offset,omitempty" jsonschema:"Optional. Index of first comment (pagination offset). Default: 0."`
+}
+
+type ListAssetCommentsOutput struct {
+	Total    int64          `json:"total" jsonschema:"The total number of comments, including replies, on the asset"`
+	Comments []AssetComment `json:"comments" jsonschema:"The comment threads on the asset, oldest first, with their replies nested"`
+	Error    string         `json:"error,omitempty" jsonschema:"Error message if the comments could not be retrieved"`
+}
+
+type AssetComment struct {
+	ID        string         `json:"id" jsonschema:"The UUID of the comment, used as parentCommentId to reply to it"`
+	Text      string         `json:"text" jsonschema:"The content of the comment as plain text"`
+	Author    string         `json:"author" jsonschema:"The full name of the user who wrote the comment"`
+	AuthorID  string         `json:"authorId" jsonschema:"The UUID of the user who wrote the comment"`
+	CreatedOn string         `json:"createdOn" jsonschema:"When the comment was written"`
+	ByAgent   bool           `json:"byAgent" jsonschema:"Whether the comment was posted by an AI agent through this server"`
+	Replies   []AssetComment `json:"replies,omitempty" jsonschema:"The replies to the comment"`
+}
+
+func NewListAssetCommentsTool(collibraClient *http.Client, agentMarker string) *chip.Tool[ListAssetCommentsInput, ListAssetCommentsOutput] {
+	return &chip.Tool[ListAssetCommentsInput, ListAssetCommentsOutput]{
+		Name:        "asset_comments_list",
+		Description: "List the comments on an asset as threads, with replies nested under the comment they answer. Comments posted by an AI agent are flagged.",
+		Handler:     handleListAssetComments(collibraClient, agentMarker),
+	}
+}
+
+func handleListAssetComments(collibraClient *http.Client, agentMarker string) chip.ToolHandlerFunc[ListAssetCommentsInput, ListAssetCommentsOutput] {
+	return func(ctx context.Context, input ListAssetCommentsInput) (ListAssetCommentsOutput, error) {
+		if input.Limit == 0 {
+			input.Limit = 100
+		}
+
+		if _, err := uuid.Parse(input.AssetID); err != nil {
+			return ListAssetCommentsOutput{Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error())}, nil
+		}
+
+		response, err := clients.FindComments(ctx, collibraClient, clients.CommentsQueryParams{
+			BaseResourceID: input.AssetID,
+			SortOrder:      "ASC",
+			Limit:          input.Limit,
+			Offset:         input.Offset,
+		})
+		if err != nil {
+			return ListAssetCommentsOutput{Error: fmt.Sprintf("Failed to retrieve comments: %s", err.Error())}, nil
+		}
+
+		authors := newOwnerResolver(collibraClient)
+		references := make([]clients.NamedResourceReference, len(response.Results))
+		for i, comment := range response.Results {
+			references[i] = clients.NamedResourceReference{ID: comment.CreatedBy, ResourceType: "User"}
+		}
+		authors.resolveAll(ctx, references)
+
+		comments := make([]AssetComment, len(response.Results))
+		for i, comment := range response.Results {
+			author := authors.resolve(ctx, references[i])
+			comments[i] = AssetComment{
+				ID:        comment.ID,
+				Text:      htmlToText(comment.Content),
+				Author:    author.Name,
+				AuthorID:  comment.CreatedBy,
+				CreatedOn: formatTimestamp(comment.CreatedOn),
+				ByAgent:   agentMarker != "" && strings.Contains(comment.Content, html.EscapeString(agentMarker)),
+			}
+		}
+
+		return ListAssetCommentsOutput{
+			Total:    response.Total,
+			Comments: threadComments(response.Results, comments),
+		}, nil
+	}
+}
+
+// threadComments nests the replies under their parent comment. Replies whose parent is not part of the
+// page are kept at the top level.
+func threadComments(raw []clients.Comment, comments []AssetComment) []AssetComment {
+	index := map[string]int{}
+	for i, comment := range raw {
+		index[comment.ID] = i
+	}
+
+	children := map[int][]int{}
+	var roots []int
+	for i, comment := range raw {
+		if comment.Parent != nil {
+			if parent, ok := index[comment.Parent.ID]; ok {
+				children[parent] = append(children[parent], i)
+				continue
+			}
+		}
+		roots = append(roots, i)
+	}
+
+	var build func(i int) AssetComment
+	build = func(i int) AssetComment {
+		comment := comments[i]
+		for _, child := range children[i] {
+			comment.Replies = append(comment.Replies, build(child))
+		}
+		return comment
+	}
+
+	threads := make([]AssetComment, len(roots))
+	for i, root := range roots {
+		threads[i] = build(root)
+	}
+	return threads
+}
+
+var (
+	htmlBreakPattern = regexp.MustCompile(`(?i)<\s*(br|/p|/div|/li)\s*/?>`)
+	htmlTagPattern   = regexp.MustCompile(`<[^>]*>`)
+)
+
+func htmlToText(content string) string {
+	text := htmlBreakPattern.ReplaceAllString(content, "\n")
+	text = htmlTagPattern.ReplaceAllString(text, "")
+	return strings.TrimSpace(html.UnescapeString(text))
+}
@@ -0,0 +1,54 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestListAssetComments(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/comments", JsonHandlerOut(func(r *http.Request) (int, clients.CommentPagedResponse) {
+		if r.URL.Query().Get("baseResourceId") != assetId {
+			t.Errorf("Expected comments for asset %s, got: %s", assetId, r.URL.Query().Get("baseResourceId"))
+		}
+		return http.StatusOK, clients.CommentPagedResponse{
+			Total: 2,
+			Results: []clients.Comment{
+				{ID: "c1", Content: "<p>Is this column &amp; its history PII?</p>", CreatedBy: "u1", CreatedOn: 1475503010320},
+				{ID: "c2", Content: "<p>Yes, it holds emails.</p><p><em>[Posted by an AI agent]</em></p>", CreatedBy: "u1", Parent: &clients.NamedResourceReference{ID: "c1"}},
+			},
+		}
+	}))
+	handler.Handle("/rest/2.0/users/u1", JsonHandlerOut(func(r *http.Request) (int, clients.UserDetails) {
+		return http.StatusOK, clients.UserDetails{ID: "u1", UserName: "jdoe", FirstName: "John", LastName: "Doe"}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewListAssetCommentsTool(newClient(server), "[Posted by an AI agent]").Handler(t.Context(), tools.ListAssetCommentsInput{
+		AssetID: assetId,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Total != 2 || len(output.Comments) != 1 {
+		t.Fatalf("Expected a single thread, got: %+v", output)
+	}
+	thread := output.Comments[0]
+	if thread.Text != "Is this column & its history PII?" || thread.Author != "John Doe" || thread.ByAgent {
+		t.Errorf("Unexpected root comment: %+v", thread)
+	}
+	if len(thread.Replies) != 1 || !thread.Replies[0].ByAgent {
+		t.Fatalf("Expected an agent reply, got: %+v", thread.Replies)
+	}
+	if thread.Replies[0].Text != "Yes, it holds emails.\n[Posted by an AI agent]" {
+		t.Errorf("Unexpected reply text: '%s'", thread.Replies[0].Text)
+	}
+}
@@ -2,10 +2,9 @@ package tools
 
 import (
 	"context"
-	"net/http"
 
 	"github.com/collibra/chip/pkg/chip"
-	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 )
 
 type ListAssetTypesInput struct {
@@ -32,27 +31,28 @@ type AssetType struct {
 	Product            string `json:"product,omitempty" jsonschema:"The product to which this asset type is linked"`
 }
 
-func NewListAssetTypesTool(collibraClient *http.Client) *chip.Tool[ListAssetTypesInput, ListAssetTypesOutput] {
+func NewListAssetTypesTool(metamodelCache *metamodel.Cache) *chip.Tool[ListAssetTypesInput, ListAssetTypesOutput] {
 	return &chip.Tool[ListAssetTypesInput, ListAssetTypesOutput]{
 		Name:        "asset_types_list",
 		Description: "List asset types available in Collibra with their properties and metadata.",
-		Handler:     handleListAssetTypes(collibraClient),
+		Handler:     handleListAssetTypes(metamodelCache),
 	}
 }
 
-func handleListAssetTypes(collibraClient *http.Client) chip.ToolHandlerFunc[ListAssetTypesInput, ListAssetTypesOutput] {
+func handleListAssetTypes(metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[ListAssetTypesInput, ListAssetTypesOutput] {
 	return func(ctx context.Context, input ListAssetTypesInput) (ListAssetTypesOutput, error) {
 		if input.Limit == 0 {
 			input.Limit = 100
 		}
 
-		response, err := clients.ListAssetTypes(ctx, collibraClient, input.Limit, input.Offset)
+		mm, err := metamodelCache.Get(ctx)
 		if err != nil {
 			return ListAssetTypesOutput{}, err
 		}
 
-		assetTypes := make([]AssetType, len(response.Results))
-		for i, at := range response.Results {
+		page := paginate(mm.AssetTypes, input.Offset, input.Limit)
+		assetTypes := make([]AssetType, len(page))
+		for i, at := range page {
 			assetTypes[i] = AssetType{
 				ID:                 at.ID,
 				Name:               at.Name,
@@ -67,10 +67,21 @@ func handleListAssetTypes(collibraClient *http.Client) chip.ToolHandlerFunc[List
 		}
 
 		return ListAssetTypesOutput{
-			Total:      response.Total,
-			Offset:     response.Offset,
-			Limit:      response.Limit,
+			Total:      int64(len(mm.AssetTypes)),
+			Offset:     int64(input.Offset),
+			Limit:      int64(input.Limit),
 			AssetTypes: assetTypes,
 		}, nil
 	}
 }
+
+func paginate[T any](items []T, offset int, limit int) []T {
+	if offset < 0 || offset >= len(items) {
+		return []T{}
+	}
+	end := len(items)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return items[offset:end]
+}
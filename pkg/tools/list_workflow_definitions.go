@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type ListWorkflowDefinitionsInput struct {
+	AssetID string `json:"assetId,omitempty" jsonschema:"Optional. The UUID of an asset. When set, only the workflow definitions that can be started for this asset are returned."`
+	Name    string `json:"name,omitempty" jsonschema:"Optional. Filter by workflow definition name. Matching is case-insensitive and supports partial matches."`
+	Limit   int    `json:"limit,omitempty" jsonschema:"Optional. Maximum number of results to return. The maximum allowed limit is 1000. Default: 50."`
+	Offset  int    `json:"offset,omitempty" jsonschema:"Optional. Index of first result (pagination offset). Default: 0."`
+}
+
+type ListWorkflowDefinitionsOutput struct {
+	Total       int64                   `json:"total" jsonschema:"The total number of workflow definitions matching the criteria"`
+	Definitions []WorkflowDefinitionRef `json:"definitions" jsonschema:"The list of enabled workflow definitions"`
+}
+
+type WorkflowDefinitionRef struct {
+	ID                       string `json:"id" jsonschema:"The UUID of the workflow definition, used to start it with workflow_start"`
+	Name                     string `json:"name" jsonschema:"The name of the workflow definition"`
+	Description              string `json:"description,omitempty" jsonschema:"The description of the workflow definition"`
+	BusinessItemResourceType string `json:"businessItemResourceType,omitempty" jsonschema:"The type of resource the workflow is started on (e.g., ASSET, DOMAIN, COMMUNITY), empty for global workflows"`
+}
+
+func NewListWorkflowDefinitionsTool(collibraClient *http.Client) *chip.Tool[ListWorkflowDefinitionsInput, ListWorkflowDefinitionsOutput] {
+	return &chip.Tool[ListWorkflowDefinitionsInput, ListWorkflowDefinitionsOutput]{
+		Name:        "workflow_definitions_list",
+		Description: "List the enabled workflow definitions in Collibra (e.g., approvals, certification, access requests). Filter by asset to only get the workflows that can be started on that asset.",
+		Handler:     handleListWorkflowDefinitions(collibraClient),
+	}
+}
+
+func handleListWorkflowDefinitions(collibraClient *http.Client) chip.ToolHandlerFunc[ListWorkflowDefinitionsInput, ListWorkflowDefinitionsOutput] {
+	return func(ctx context.Context, input ListWorkflowDefinitionsInput) (ListWorkflowDefinitionsOutput, error) {
+		if input.Limit == 0 {
+			input.Limit = 50
+		}
+
+		if input.AssetID != "" {
+			if _, err := uuid.Parse(input.AssetID); err != nil {
+				return ListWorkflowDefinitionsOutput{}, fmt.Errorf("invalid asset ID format: %w", err)
+			}
+		}
+
+		response, err := clients.ListWorkflowDefinitions(ctx, collibraClient, clients.WorkflowDefinitionsQueryParams{
+			AssetID: input.AssetID,
+			Name:    strings.TrimSpace(input.Name),
+			Enabled: true,
+			Limit:   input.Limit,
+			Offset:  input.Offset,
+		})
+		if err != nil {
+			return ListWorkflowDefinitionsOutput{}, err
+		}
+
+		definitions := make([]WorkflowDefinitionRef, len(response.Results))
+		for i, definition := range response.Results {
+			definitions[i] = WorkflowDefinitionRef{
+				ID:                       definition.ID,
+				Name:                     definition.Name,
+				Description:              definition.Description,
+				BusinessItemResourceType: definition.BusinessItemResourceType,
+			}
+		}
+
+		return ListWorkflowDefinitionsOutput{
+			Total:       response.Total,
+			Definitions: definitions,
+		}, nil
+	}
+}
@@ -0,0 +1,46 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestListWorkflowDefinitions(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/workflowDefinitions", JsonHandlerOut(func(r *http.Request) (int, clients.WorkflowDefinitionPagedResponse) {
+		if r.URL.Query().Get("assetId") != assetId {
+			t.Errorf("Expected definitions for asset %s, got: %s", assetId, r.URL.Query().Get("assetId"))
+		}
+		if r.URL.Query().Get("enabled") != "true" {
+			t.Errorf("Expected only enabled definitions to be requested")
+		}
+		return http.StatusOK, clients.WorkflowDefinitionPagedResponse{
+			Total: 1,
+			Results: []clients.WorkflowDefinition{
+				{ID: "3c0f0b8e-52a4-4bb5-8c9d-ecc3b4a4e7a1", Name: "Request Access", Enabled: true, BusinessItemResourceType: "ASSET"},
+			},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewListWorkflowDefinitionsTool(newClient(server)).Handler(t.Context(), tools.ListWorkflowDefinitionsInput{
+		AssetID: assetId,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Total != 1 || len(output.Definitions) != 1 {
+		t.Fatalf("Expected 1 definition, got: %+v", output)
+	}
+	if output.Definitions[0].Name != "Request Access" {
+		t.Errorf("Expected definition 'Request Access', got: '%s'", output.Definitions[0].Name)
+	}
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type ListWorkflowTasksInput struct {
+	AssetID string `json:"assetId,omitempty" jsonschema:"Optional. The UUID of an asset. When set, only the tasks about this asset are returned."`
+	Limit   int    `json:"limit,omitempty" jsonschema:"Optional. Maximum number of results to return. The maximum allowed limit is 1000. Default: 50."`
+	Offset  int    `json:"offset,omitempty" jsonschema:"Optional. Index of first result (pagination offset). Default: 0."`
+}
+
+type ListWorkflowTasksOutput struct {
+	Total int64          `json:"total" jsonschema:"The total number of open tasks"`
+	Tasks []WorkflowTask `json:"tasks" jsonschema:"The open workflow tasks assigned to the current user"`
+}
+
+type WorkflowTask struct {
+	ID                 string `json:"id" jsonschema:"The ID of the task, used to complete it with workflow_task_complete"`
+	Title              string `json:"title" jsonschema:"The title of the task"`
+	Description        string `json:"description,omitempty" jsonschema:"The description of the task"`
+	Type               string `json:"type,omitempty" jsonschema:"The type of the task"`
+	Workflow           string `json:"workflow,omitempty" jsonschema:"The name of the workflow the task belongs to"`
+	BusinessItemID     string `json:"businessItemId,omitempty" jsonschema:"The UUID of the asset, domain or community the task is about"`
+	BusinessItemName   string `json:"businessItemName,omitempty" jsonschema:"The name of the asset, domain or community the task is about"`
+	CreatedOn          string `json:"createdOn,omitempty" jsonschema:"When the task was created"`
+	DueDate            string `json:"dueDate,omitempty" jsonschema:"When the task is due"`
+	WorkflowInstanceID string `json:"workflowInstanceId,omitempty" jsonschema:"The ID of the workflow instance the task belongs to"`
+}
+
+func NewListWorkflowTasksTool(collibraClient *http.Client) *chip.Tool[ListWorkflowTasksInput, ListWorkflowTasksOutput] {
+	return &chip.Tool[ListWorkflowTasksInput, ListWorkflowTasksOutput]{
+		Name:        "workflow_tasks_list",
+		Description: "List the open workflow tasks in the current user's Collibra task inbox (e.g., approvals waiting for them), optionally limited to the tasks about a given asset.",
+		Handler:     handleListWorkflowTasks(collibraClient),
+	}
+}
+
+func handleListWorkflowTasks(collibraClient *http.Client) chip.ToolHandlerFunc[ListWorkflowTasksInput, ListWorkflowTasksOutput] {
+	return func(ctx context.Context, input ListWorkflowTasksInput) (ListWorkflowTasksOutput, error) {
+		if input.Limit == 0 {
+			input.Limit = 50
+		}
+
+		if input.AssetID != "" {
+			if _, err := uuid.Parse(input.AssetID); err != nil {
+				return ListWorkflowTasksOutput{}, fmt.Errorf("invalid asset ID format: %w", err)
+			}
+		}
+
+		response, err := clients.ListWorkflowTasks(ctx, collibraClient, clients.WorkflowTasksQueryParams{
+			BusinessItemID: input.AssetID,
+			Limit:          input.Limit,
+			Offset:         input.Offset,
+		})
+		if err != nil {
+			return ListWorkflowTasksOutput{}, err
+		}
+
+		tasks := make([]WorkflowTask, len(response.Results))
+		for i, task := range response.Results {
+			tasks[i] = WorkflowTask{
+				ID:                 task.ID,
+				Title:              task.Title,
+				Description:        task.Description,
+				Type:               task.Type,
+				WorkflowInstanceID: task.WorkflowInstanceID,
+			}
+			if task.WorkflowDefinition != nil {
+				tasks[i].Workflow = task.WorkflowDefinition.Name
+			}
+			if task.BusinessItem != nil {
+				tasks[i].BusinessItemID = task.BusinessItem.ID
+				tasks[i].BusinessItemName = task.BusinessItem.Name
+			}
+			if task.CreateTime != 0 {
+				tasks[i].CreatedOn = formatTimestamp(task.CreateTime)
+			}
+			if task.DueDate != 0 {
+				tasks[i].DueDate = formatTimestamp(task.DueDate)
+			}
+		}
+
+		return ListWorkflowTasksOutput{
+			Total: response.Total,
+			Tasks: tasks,
+		}, nil
+	}
+}
@@ -0,0 +1,44 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestListWorkflowTasks(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/workflowTasks", JsonHandlerOut(func(r *http.Request) (int, clients.WorkflowTaskPagedResponse) {
+		return http.StatusOK, clients.WorkflowTaskPagedResponse{
+			Total: 1,
+			Results: []clients.WorkflowTask{
+				{
+					ID:                 "task-1",
+					Title:              "Approve access request",
+					CreateTime:         1475503010320,
+					WorkflowDefinition: &clients.NamedResourceReference{ID: workflowDefinitionId, Name: "Request Access"},
+					BusinessItem:       &clients.NamedResourceReference{ID: "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8", ResourceType: "Asset", Name: "customer_orders"},
+				},
+			},
+		}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewListWorkflowTasksTool(newClient(server)).Handler(t.Context(), tools.ListWorkflowTasksInput{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if output.Total != 1 || len(output.Tasks) != 1 {
+		t.Fatalf("Expected 1 task, got: %+v", output)
+	}
+	task := output.Tasks[0]
+	if task.Workflow != "Request Access" || task.BusinessItemName != "customer_orders" || task.CreatedOn == "" {
+		t.Errorf("Unexpected task: %+v", task)
+	}
+}
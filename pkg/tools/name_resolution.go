@@ -0,0 +1,173 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/google/uuid"
+)
+
+type namedResource struct {
+	ID      string
+	Name    string
+	Label   string
+	Aliases []string
+}
+
+func (r namedResource) describe() string {
+	if r.Label != "" && r.Label != r.Name {
+		return fmt.Sprintf("'%s' (%s, id: %s)", r.Name, r.Label, r.ID)
+	}
+	return fmt.Sprintf("'%s' (id: %s)", r.Name, r.ID)
+}
+
+// resolveNames maps each value to an ID. Values that are UUIDs are kept as-is, other values are matched
+// case-insensitively against the names (and labels and aliases) of the candidates.
+func resolveNames(kind string, values []string, candidates func(value string) ([]namedResource, error)) ([]string, error) {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if _, err := uuid.Parse(value); err == nil {
+			ids = append(ids, value)
+			continue
+		}
+
+		resources, err := candidates(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s '%s': %w", kind, value, err)
+		}
+
+		matches := matchNamedResources(value, resources)
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("unknown %s '%s': no %s with that name exists, use a valid name or UUID", kind, value, kind)
+		case 1:
+			ids = append(ids, matches[0].ID)
+		default:
+			descriptions := make([]string, len(matches))
+			for i, match := range matches {
+				descriptions[i] = match.describe()
+			}
+			return nil, fmt.Errorf("ambiguous %s '%s': it matches %d candidates, use one of their UUIDs instead: %s", kind, value, len(matches), strings.Join(descriptions, ", "))
+		}
+	}
+	return ids, nil
+}
+
+func matchNamedResources(value string, resources []namedResource) []namedResource {
+	var matches []namedResource
+	for _, resource := range resources {
+		if strings.EqualFold(resource.Name, value) || (resource.Label != "" && strings.EqualFold(resource.Label, value)) ||
+			slices.ContainsFunc(resource.Aliases, func(alias string) bool { return strings.EqualFold(alias, value) }) {
+			matches = append(matches, resource)
+		}
+	}
+	return matches
+}
+
+func resolveAssetTypeNames(ctx context.Context, metamodelCache *metamodel.Cache, values []string) ([]string, error) {
+	return resolveNames("asset type", values, func(string) ([]namedResource, error) {
+		mm, err := metamodelCache.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]namedResource, len(mm.AssetTypes))
+		for i, assetType := range mm.AssetTypes {
+			resources[i] = namedResource{ID: assetType.ID, Name: assetType.Name, Label: assetType.PublicId}
+		}
+		return resources, nil
+	})
+}
+
+func resolveAttributeTypeNames(ctx context.Context, metamodelCache *metamodel.Cache, values []string) ([]string, error) {
+	return resolveNames("attribute type", values, func(string) ([]namedResource, error) {
+		mm, err := metamodelCache.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]namedResource, len(mm.AttributeTypes))
+		for i, attributeType := range mm.AttributeTypes {
+			resources[i] = namedResource{ID: attributeType.ID, Name: attributeType.Name, Label: attributeType.PublicId}
+		}
+		return resources, nil
+	})
+}
+
+func resolveRelationTypeNames(ctx context.Context, metamodelCache *metamodel.Cache, values []string) ([]string, error) {
+	return resolveNames("relation type", values, func(string) ([]namedResource, error) {
+		mm, err := metamodelCache.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Roles and co-roles are often shared by many relation types, so a relation type can also be named by
+		// its public ID or qualified with its source and target types, e.g. 'Table contains Column'.
+		resources := make([]namedResource, len(mm.RelationTypes))
+		for i, relationType := range mm.RelationTypes {
+			source, target := relationType.SourceType.Name, relationType.TargetType.Name
+			resources[i] = namedResource{
+				ID:    relationType.ID,
+				Name:  fmt.Sprintf("%s %s %s", source, relationType.Role, target),
+				Label: relationType.PublicId,
+				Aliases: []string{
+					relationType.Role,
+					relationType.CoRole,
+					fmt.Sprintf("%s %s %s", target, relationType.CoRole, source),
+				},
+			}
+		}
+		return resources, nil
+	})
+}
+
+func resolveStatusNames(ctx context.Context, metamodelCache *metamodel.Cache, values []string) ([]string, error) {
+	return resolveNames("status", values, func(string) ([]namedResource, error) {
+		mm, err := metamodelCache.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]namedResource, len(mm.Statuses))
+		for i, status := range mm.Statuses {
+			resources[i] = namedResource{ID: status.ID, Name: status.Name}
+		}
+		return resources, nil
+	})
+}
+
+func resolveDomainTypeNames(ctx context.Context, metamodelCache *metamodel.Cache, values []string) ([]string, error) {
+	return resolveNames("domain type", values, func(string) ([]namedResource, error) {
+		mm, err := metamodelCache.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]namedResource, len(mm.DomainTypes))
+		for i, domainType := range mm.DomainTypes {
+			resources[i] = namedResource{ID: domainType.ID, Name: domainType.Name, Label: domainType.PublicId}
+		}
+		return resources, nil
+	})
+}
+
+func resolveUserNames(ctx context.Context, userCache *clients.UserCache, values []string) ([]string, error) {
+	return resolveNames("user", values, func(value string) ([]namedResource, error) {
+		users, err := userCache.Users(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+		var resources []namedResource
+		for _, user := range users {
+			if strings.EqualFold(user.EmailAddress, value) {
+				resources = append(resources, namedResource{ID: user.ID, Name: user.EmailAddress, Label: user.UserName})
+				continue
+			}
+			resources = append(resources, namedResource{ID: user.ID, Name: user.UserName, Label: user.FullName()})
+		}
+		return resources, nil
+	})
+}
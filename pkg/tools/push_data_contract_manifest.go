@@ -1,34 +1,40 @@
 package tools
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/collibra/chip/pkg/chip"
 	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/datacontract"
 )
 
 type PushDataContractManifestInput struct {
-	ManifestID string `json:"manifestId,omitempty" jsonschema:"The unique identifier of the data contract as specified in the manifest. If omitted and a manifest that adheres to the Open Data Contract Standard is provided, the manifestID will be parsed automatically. Maximum length: 200 characters."`
-	Manifest   string `json:"manifest" jsonschema:"The content of the data contract manifest file"`
-	Version    string `json:"version,omitempty" jsonschema:"Optional. The version of the data contract manifest being uploaded. If omitted, the version will be parsed automatically from the manifest unless it does not adhere to the Open Data Contract Standard. Maximum length: 100 characters."`
-	Force      bool   `json:"force,omitempty" jsonschema:"Optional. Set to true to force the overwrite of an existing manifest version if it has the same version value. When a new manifest overwrites the active version, the 'active' parameter in the request is ignored, and the version's active state remains unchanged. Defaults to false."`
-	Active     bool   `json:"active,omitempty" jsonschema:"Optional. Set to true to make this data contract manifest version the active version. This will automatically deactivate the previous active version. The active version is the one that's exposed through the data contract asset. Defaults to true."`
+	ManifestID     string `json:"manifestId,omitempty" jsonschema:"The unique identifier of the data contract as specified in the manifest. If omitted and a manifest that adheres to the Open Data Contract Standard is provided, the manifestID will be parsed automatically. Maximum length: 200 characters."`
+	Manifest       string `json:"manifest" jsonschema:"The content of the data contract manifest file"`
+	Version        string `json:"version,omitempty" jsonschema:"Optional. The version of the data contract manifest being uploaded. If omitted, the version will be parsed automatically from the manifest unless it does not adhere to the Open Data Contract Standard. Maximum length: 100 characters."`
+	Force          bool   `json:"force,omitempty" jsonschema:"Optional. Set to true to force the overwrite of an existing manifest version if it has the same version value. When a new manifest overwrites the active version, the 'active' parameter in the request is ignored, and the version's active state remains unchanged. Also set to true to upload a manifest with breaking changes compared to the active version. Defaults to false."`
+	Active         bool   `json:"active,omitempty" jsonschema:"Optional. Set to true to make this data contract manifest version the active version. This will automatically deactivate the previous active version. The active version is the one that's exposed through the data contract asset. Defaults to true."`
+	SkipValidation bool   `json:"skipValidation,omitempty" jsonschema:"Optional. Set to true to upload the manifest even when it does not pass the Open Data Contract Standard validation. A manifest that is not valid YAML is never uploaded. Defaults to false."`
 }
 
 type PushDataContractManifestOutput struct {
-	ID         string `json:"id,omitempty" jsonschema:"The UUID of the data contract asset"`
-	DomainID   string `json:"domainId,omitempty" jsonschema:"The UUID of the domain where the data contract asset is located"`
-	ManifestID string `json:"manifestId,omitempty" jsonschema:"The unique identifier of the data contract manifest"`
-	Error      string `json:"error,omitempty" jsonschema:"Error message if the manifest could not be uploaded"`
-	Success    bool   `json:"success" jsonschema:"Whether the manifest was successfully uploaded"`
+	ID               string                 `json:"id,omitempty" jsonschema:"The UUID of the data contract asset"`
+	DomainID         string                 `json:"domainId,omitempty" jsonschema:"The UUID of the domain where the data contract asset is located"`
+	ManifestID       string                 `json:"manifestId,omitempty" jsonschema:"The unique identifier of the data contract manifest"`
+	Error            string                 `json:"error,omitempty" jsonschema:"Error message if the manifest could not be uploaded"`
+	Success          bool                   `json:"success" jsonschema:"Whether the manifest was successfully uploaded"`
+	ValidationErrors []datacontract.Finding `json:"validationErrors,omitempty" jsonschema:"The errors that prevented the upload, found by validating the manifest against the Open Data Contract Standard"`
+	Warnings         []datacontract.Finding `json:"warnings,omitempty" jsonschema:"The warnings found by validating the manifest against the Open Data Contract Standard"`
+	BreakingChanges  []datacontract.Change  `json:"breakingChanges,omitempty" jsonschema:"The breaking changes compared to the active version that prevented the upload"`
 }
 
 func NewPushDataContractManifestTool(collibraClient *http.Client) *chip.Tool[PushDataContractManifestInput, PushDataContractManifestOutput] {
 	return &chip.Tool[PushDataContractManifestInput, PushDataContractManifestOutput]{
 		Name:        "data_contract_manifest_push",
-		Description: "Upload a new version of a data contract manifest to Collibra. The manifestID and version are automatically parsed from the manifest content if it adheres to the Open Data Contract Standard.",
+		Description: "Upload a new version of a data contract manifest to Collibra. The manifestID and version are automatically parsed from the manifest content if it adheres to the Open Data Contract Standard. The manifest is first validated as data_contract_manifest_validate does and is not uploaded when it has errors, unless skipValidation is set; a manifest that is not valid YAML is always rejected. A manifest with breaking changes compared to the active version, as reported by data_contract_manifest_diff, is not uploaded unless force is set.",
 		Handler:     handlePushDataContractManifest(collibraClient),
 	}
 }
@@ -42,6 +48,42 @@ func handlePushDataContractManifest(collibraClient *http.Client) chip.ToolHandle
 			}, nil
 		}
 
+		report := datacontract.Validate([]byte(input.Manifest))
+		if !report.Parsed() {
+			return PushDataContractManifestOutput{
+				Error:            "The manifest is not valid YAML",
+				Success:          false,
+				ValidationErrors: report.Errors(),
+			}, nil
+		}
+		if !report.Valid() && !input.SkipValidation {
+			return PushDataContractManifestOutput{
+				Error:            fmt.Sprintf("The manifest has %d validation errors, fix them or set skipValidation to upload it anyway", len(report.Errors())),
+				Success:          false,
+				ValidationErrors: report.Errors(),
+				Warnings:         report.Warnings(),
+			}, nil
+		}
+
+		if !input.Force {
+			breakingChanges, err := findBreakingChanges(ctx, collibraClient, input)
+			if err != nil {
+				return PushDataContractManifestOutput{
+					Error:    fmt.Sprintf("Failed to compare the manifest with the active version: %s. Set force to upload it without comparing", err.Error()),
+					Success:  false,
+					Warnings: report.Warnings(),
+				}, nil
+			}
+			if len(breakingChanges) > 0 {
+				return PushDataContractManifestOutput{
+					Error:           fmt.Sprintf("The manifest has %d breaking changes compared to the active version. Setting force uploads it anyway, but also overwrites an existing version with the same version number", len(breakingChanges)),
+					Success:         false,
+					Warnings:        report.Warnings(),
+					BreakingChanges: breakingChanges,
+				}, nil
+			}
+		}
+
 		req := clients.PushDataContractManifestRequest{
 			Manifest:   input.Manifest,
 			ManifestID: input.ManifestID,
@@ -63,6 +105,32 @@ func handlePushDataContractManifest(collibraClient *http.Client) chip.ToolHandle
 			DomainID:   response.DomainID,
 			ManifestID: response.ManifestID,
 			Success:    true,
+			Warnings:   report.Warnings(),
 		}, nil
 	}
 }
+
+// findBreakingChanges compares an ODCS manifest with the active version of its data contract. Manifests that do
+// not follow ODCS and data contracts that do not exist yet have nothing to compare with.
+func findBreakingChanges(ctx context.Context, collibraClient *http.Client, input PushDataContractManifestInput) ([]datacontract.Change, error) {
+	next, err := datacontract.Parse([]byte(input.Manifest))
+	if err != nil {
+		return nil, nil
+	}
+	active, err := pullActiveManifest(ctx, collibraClient, "", cmp.Or(input.ManifestID, next.ID))
+	if err != nil || active == nil {
+		return nil, err
+	}
+	previous, err := datacontract.Parse(active.manifest)
+	if err != nil {
+		return nil, nil
+	}
+
+	var breakingChanges []datacontract.Change
+	for _, change := range datacontract.Diff(previous, next) {
+		if change.Breaking {
+			breakingChanges = append(breakingChanges, change)
+		}
+	}
+	return breakingChanges, nil
+}
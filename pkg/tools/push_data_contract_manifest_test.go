@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/collibra/chip/pkg/clients"
 	"github.com/collibra/chip/pkg/tools"
 )
 
@@ -145,8 +146,23 @@ func TestPushDataContractManifestWithOptionalParams(t *testing.T) {
 	defer server.Close()
 
 	client := newClient(server)
-	output, err := tools.NewPushDataContractManifestTool(client).Handler(t.Context(), tools.PushDataContractManifestInput{
-		Manifest:   manifestContent,
+	tool := tools.NewPushDataContractManifestTool(client)
+	output, err := tool.Handler(t.Context(), tools.PushDataContractManifestInput{
+		Manifest:       manifestContent,
+		ManifestID:     "test-manifest-456",
+		Version:        "1.0.0",
+		Force:          true,
+		SkipValidation: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || len(output.ValidationErrors) != 1 || output.ValidationErrors[0].Line == 0 {
+		t.Fatalf("Expected a manifest that is not valid YAML to be rejected with its syntax error, got: %+v", output)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.PushDataContractManifestInput{
+		Manifest:   strings.ReplaceAll(manifestContent, "\t", ""),
 		ManifestID: "test-manifest-456",
 		Version:    "1.0.0",
 		Force:      true,
@@ -214,3 +230,116 @@ kind: DataContract`
 		t.Fatal("Expected error message for server error")
 	}
 }
+
+func TestPushDataContractManifestCheck(t *testing.T) {
+	manifestContent := `apiVersion: v3.0.2
+kind: DataContract
+id: orders-contract
+version: 1.0.0
+schema:
+  - name: orders
+    properties:
+      - name: order_id
+        logicalType: bigint`
+
+	pushed := 0
+	handler := http.NewServeMux()
+	handler.Handle("/rest/dataProduct/v1/dataContracts/addFromManifest", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pushed++
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "00000000-0000-0000-0000-000000000005", "manifestId": "orders-contract"}`))
+	}))
+	handler.Handle("/rest/dataProduct/v1/dataContracts", JsonHandlerOut(func(r *http.Request) (int, clients.DataContractListPaginated) {
+		return http.StatusOK, clients.DataContractListPaginated{Items: []clients.DataContract{}}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewPushDataContractManifestTool(newClient(server))
+	output, err := tool.Handler(t.Context(), tools.PushDataContractManifestInput{Manifest: manifestContent})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || pushed != 0 {
+		t.Fatal("Expected an invalid manifest not to be uploaded")
+	}
+	if len(output.ValidationErrors) != 2 || output.ValidationErrors[0].Line != 1 || output.ValidationErrors[1].Line != 9 {
+		t.Fatalf("Expected the missing status and the invalid logical type to be reported, got: %+v", output.ValidationErrors)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.PushDataContractManifestInput{Manifest: manifestContent, SkipValidation: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || pushed != 1 || len(output.Warnings) == 0 {
+		t.Fatalf("Expected the manifest to be uploaded with warnings when skipping validation, got: %+v", output)
+	}
+}
+
+func TestPushDataContractManifestBreakingChanges(t *testing.T) {
+	activeManifest := `apiVersion: v3.0.2
+kind: DataContract
+id: orders-contract
+version: 1.0.0
+status: active
+schema:
+  - name: orders
+    properties:
+      - name: order_id
+        logicalType: integer
+      - name: amount
+        logicalType: number`
+	newManifest := strings.Replace(strings.Replace(activeManifest, "1.0.0", "2.0.0", 1), "logicalType: number", "logicalType: string", 1)
+
+	pushed := 0
+	handler := http.NewServeMux()
+	handler.Handle("/rest/dataProduct/v1/dataContracts", JsonHandlerOut(func(r *http.Request) (int, clients.DataContractListPaginated) {
+		if r.URL.Query().Get("manifestId") != "orders-contract" {
+			return http.StatusOK, clients.DataContractListPaginated{Items: []clients.DataContract{}}
+		}
+		return http.StatusOK, clients.DataContractListPaginated{Items: []clients.DataContract{{ID: "00000000-0000-0000-0000-000000000005", ManifestID: "orders-contract"}}}
+	}))
+	handler.Handle("/rest/dataProduct/v1/dataContracts/00000000-0000-0000-0000-000000000005/activeVersion/manifest", StringHandlerOut(func(r *http.Request) (int, string) {
+		return http.StatusOK, activeManifest
+	}))
+	handler.Handle("/rest/dataProduct/v1/dataContracts/addFromManifest", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pushed++
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "00000000-0000-0000-0000-000000000005", "manifestId": "orders-contract"}`))
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewPushDataContractManifestTool(newClient(server))
+	output, err := tool.Handler(t.Context(), tools.PushDataContractManifestInput{Manifest: newManifest})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || pushed != 0 {
+		t.Fatal("Expected a manifest with breaking changes not to be uploaded")
+	}
+	if len(output.BreakingChanges) != 1 || output.BreakingChanges[0].Element != "orders.amount" {
+		t.Fatalf("Expected the retyped column to be reported, got: %+v", output.BreakingChanges)
+	}
+	if !strings.Contains(output.Error, "overwrites an existing version with the same version number") {
+		t.Errorf("Expected the error to warn that force also overwrites an existing version, got: %s", output.Error)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.PushDataContractManifestInput{Manifest: newManifest, Force: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || pushed != 1 {
+		t.Fatalf("Expected the manifest to be uploaded when forced, got: %+v", output)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.PushDataContractManifestInput{Manifest: strings.Replace(newManifest, "id: orders-contract", "id: payments-contract", 1)})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || pushed != 2 {
+		t.Fatalf("Expected the manifest of a new data contract to be uploaded, got: %+v", output)
+	}
+}
@@ -0,0 +1,48 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type RemoveAssetTagsInput struct {
+	AssetID string   `json:"assetId" jsonschema:"Required. The UUID of the asset to remove the tags from."`
+	Tags    []string `json:"tags" jsonschema:"Required. The names of the tags to remove."`
+}
+
+type RemoveAssetTagsOutput struct {
+	Success bool   `json:"success" jsonschema:"Whether the tags were successfully removed"`
+	Error   string `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+}
+
+func NewRemoveAssetTagsTool(collibraClient *http.Client) *chip.Tool[RemoveAssetTagsInput, RemoveAssetTagsOutput] {
+	return &chip.Tool[RemoveAssetTagsInput, RemoveAssetTagsOutput]{
+		Name:        "asset_tags_remove",
+		Description: "Remove one or more tags from an asset.",
+		Handler:     handleRemoveAssetTags(collibraClient),
+	}
+}
+
+func handleRemoveAssetTags(collibraClient *http.Client) chip.ToolHandlerFunc[RemoveAssetTagsInput, RemoveAssetTagsOutput] {
+	return func(ctx context.Context, input RemoveAssetTagsInput) (RemoveAssetTagsOutput, error) {
+		if _, err := uuid.Parse(input.AssetID); err != nil {
+			return RemoveAssetTagsOutput{Success: false, Error: fmt.Sprintf("Invalid asset ID format: %s", err.Error())}, nil
+		}
+
+		names := cleanTagNames(input.Tags)
+		if len(names) == 0 {
+			return RemoveAssetTagsOutput{Success: false, Error: "At least one tag is required"}, nil
+		}
+
+		if err := clients.RemoveAssetTags(ctx, collibraClient, input.AssetID, names); err != nil {
+			return RemoveAssetTagsOutput{Success: false, Error: fmt.Sprintf("Failed to remove tags: %s", err.Error())}, nil
+		}
+
+		return RemoveAssetTagsOutput{Success: true}, nil
+	}
+}
@@ -0,0 +1,39 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestRemoveAssetTags(t *testing.T) {
+	assetId := "9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"
+
+	var removed clients.AssetTagsRequest
+	handler := http.NewServeMux()
+	handler.Handle("DELETE /rest/2.0/assets/"+assetId+"/tags", JsonHandlerInOut(func(r *http.Request, request clients.AssetTagsRequest) (int, struct{}) {
+		removed = request
+		return http.StatusOK, struct{}{}
+	}))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewRemoveAssetTagsTool(newClient(server)).Handler(t.Context(), tools.RemoveAssetTagsInput{
+		AssetID: assetId,
+		Tags:    []string{"deprecated"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected success, got: %+v", output)
+	}
+	if !slices.Equal(removed.TagNames, []string{"deprecated"}) {
+		t.Errorf("Expected the tag names to be sent, got: %v", removed.TagNames)
+	}
+}
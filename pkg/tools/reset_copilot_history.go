@@ -0,0 +1,37 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/collibra/chip/pkg/chip"
+)
+
+type ResetCopilotHistoryInput struct {
+	Agent string `json:"agent,omitempty" jsonschema:"Optional. The tool whose conversation to forget: data_assets_discover or business_glossary_discover. Forgets both when empty."`
+}
+
+type ResetCopilotHistoryOutput struct {
+	Cleared int    `json:"cleared" jsonschema:"The number of messages forgotten"`
+	Success bool   `json:"success" jsonschema:"Whether the history was reset"`
+	Error   string `json:"error,omitempty" jsonschema:"Error message if the history could not be reset"`
+}
+
+func NewResetCopilotHistoryTool(history *CopilotHistory) *chip.Tool[ResetCopilotHistoryInput, ResetCopilotHistoryOutput] {
+	return &chip.Tool[ResetCopilotHistoryInput, ResetCopilotHistoryOutput]{
+		Name:        "copilot_history_reset",
+		Description: "Forget the earlier questions and answers of this session with the data asset discovery and business glossary agents, so the next question starts a new conversation.",
+		Handler:     handleResetCopilotHistory(history),
+	}
+}
+
+func handleResetCopilotHistory(history *CopilotHistory) chip.ToolHandlerFunc[ResetCopilotHistoryInput, ResetCopilotHistoryOutput] {
+	return func(ctx context.Context, input ResetCopilotHistoryInput) (ResetCopilotHistoryOutput, error) {
+		switch input.Agent {
+		case "", "data_assets_discover", "business_glossary_discover":
+		default:
+			return ResetCopilotHistoryOutput{Success: false, Error: fmt.Sprintf("Unknown agent '%s', expected data_assets_discover or business_glossary_discover", input.Agent)}, nil
+		}
+		return ResetCopilotHistoryOutput{Cleared: history.Reset(chip.GetSessionId(ctx), input.Agent), Success: true}, nil
+	}
+}
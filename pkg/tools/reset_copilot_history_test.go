@@ -0,0 +1,56 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestCopilotHistory_Bounded(t *testing.T) {
+	history := tools.NewCopilotHistory(2)
+	for _, question := range []string{"first", "second", "third"} {
+		history.Record("session", "data_assets_discover", clients.NewToolMessage("user", question, ""), "answer to "+question)
+	}
+
+	messages := history.Messages("session", "data_assets_discover")
+	if len(messages) != 4 {
+		t.Fatalf("Expected the last two turns to be kept, got: %+v", messages)
+	}
+	if messages[0].Content.Text != "second" || messages[3].Content.Text != "answer to third" {
+		t.Errorf("Expected the oldest turn to be dropped, got: %+v", messages)
+	}
+	if len(history.Messages("other session", "data_assets_discover")) != 0 {
+		t.Errorf("Expected sessions not to share history")
+	}
+}
+
+func TestResetCopilotHistory(t *testing.T) {
+	history := tools.NewCopilotHistory(10)
+	ctx := chip.SetCallToolRequest(t.Context(), &mcp.CallToolRequest{Session: &mcp.ServerSession{}})
+	sessionID := chip.GetSessionId(ctx)
+	history.Record(sessionID, "data_assets_discover", clients.NewToolMessage("user", "question", ""), "answer")
+	history.Record(sessionID, "business_glossary_discover", clients.NewToolMessage("user", "question", ""), "answer")
+
+	tool := tools.NewResetCopilotHistoryTool(history)
+	output, err := tool.Handler(ctx, tools.ResetCopilotHistoryInput{Agent: "data_assets_discover"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Cleared != 2 {
+		t.Errorf("Expected one conversation to be cleared, got: %+v", output)
+	}
+	if len(history.Messages(sessionID, "business_glossary_discover")) != 2 {
+		t.Errorf("Expected the glossary conversation to be kept")
+	}
+
+	output, err = tool.Handler(ctx, tools.ResetCopilotHistoryInput{Agent: "unknown"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success {
+		t.Errorf("Expected an unknown agent to be rejected, got: %+v", output)
+	}
+}
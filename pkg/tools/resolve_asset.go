@@ -0,0 +1,172 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+)
+
+const (
+	resolveSearchLimit     = 25
+	resolveCandidatesLimit = 5
+	// A match is confident when it has at least an exact name match and leads the next candidate by a clear margin.
+	confidentScore  = 50
+	confidentMargin = 20
+)
+
+var resolveStopWords = []string{"the", "and", "for", "from", "with"}
+
+type ResolveAssetInput struct {
+	Name      string `json:"name" jsonschema:"Required. The name of the asset as known to the user, e.g. 'customer_orders'."`
+	AssetType string `json:"assetType,omitempty" jsonschema:"Optional. The asset type, given as a name (e.g. 'Table', 'Business Term') or UUID. Only assets of this type are considered."`
+	Context   string `json:"context,omitempty" jsonschema:"Optional. Where the asset lives, e.g. a domain, community, schema or system name such as 'sales DWH'. Used to rank the candidates."`
+}
+
+type ResolveAssetOutput struct {
+	Match      *ResolvedAsset  `json:"match,omitempty" jsonschema:"The asset the name resolves to, when there is a single confident match"`
+	Candidates []ResolvedAsset `json:"candidates,omitempty" jsonschema:"The best candidates, best first, when there is no single confident match. Ask the user which one is meant or refine the hints."`
+	Error      string          `json:"error,omitempty" jsonschema:"Error message if the name could not be resolved"`
+}
+
+type ResolvedAsset struct {
+	ID        string   `json:"id" jsonschema:"The UUID of the asset"`
+	Name      string   `json:"name" jsonschema:"The display name of the asset"`
+	FullName  string   `json:"fullName,omitempty" jsonschema:"The full name of the asset, usually including the path of its parents"`
+	AssetType string   `json:"assetType,omitempty" jsonschema:"The asset type"`
+	Domain    string   `json:"domain,omitempty" jsonschema:"The name of the domain of the asset"`
+	Community string   `json:"community,omitempty" jsonschema:"The name of the community of the asset"`
+	Status    string   `json:"status,omitempty" jsonschema:"The status of the asset"`
+	Link      string   `json:"link" jsonschema:"The link to view the asset in Collibra"`
+	Score     int      `json:"score" jsonschema:"How well the asset matches the name and hints, higher is better"`
+	Reasons   []string `json:"reasons" jsonschema:"Why the asset matched"`
+}
+
+func NewResolveAssetTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[ResolveAssetInput, ResolveAssetOutput] {
+	return &chip.Tool[ResolveAssetInput, ResolveAssetOutput]{
+		Name:        "asset_resolve",
+		Description: "Resolve an asset name as a user would say it (e.g. 'customer_orders table in the sales DWH') to its UUID. Pass the name, optionally the asset type and where the asset lives. Returns a single match when it is confident, or a short ranked list of candidates to disambiguate otherwise. Use it instead of searching and fetching details to find an asset ID.",
+		Handler:     handleResolveAsset(collibraClient, metamodelCache),
+	}
+}
+
+func handleResolveAsset(collibraClient *http.Client, metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[ResolveAssetInput, ResolveAssetOutput] {
+	return func(ctx context.Context, input ResolveAssetInput) (ResolveAssetOutput, error) {
+		name := strings.TrimSpace(input.Name)
+		if name == "" {
+			return ResolveAssetOutput{Error: "Name is required"}, nil
+		}
+
+		var filters []clients.SearchFilter
+		if input.AssetType != "" {
+			assetTypeIDs, err := resolveAssetTypeNames(ctx, metamodelCache, []string{input.AssetType})
+			if err != nil {
+				return ResolveAssetOutput{Error: err.Error()}, nil
+			}
+			filters = append(filters, clients.SearchFilter{Field: "assetType", Values: assetTypeIDs})
+		}
+
+		searchRequest := clients.CreateSearchRequest(name, []string{"Asset"}, filters, resolveSearchLimit, 0)
+		searchRequest.SearchInFields = []clients.SearchField{{ResourceType: "Asset", Fields: []string{"name", "displayName"}}}
+		searchResponse, err := clients.SearchKeyword(ctx, collibraClient, searchRequest)
+		if err != nil {
+			return ResolveAssetOutput{Error: fmt.Sprintf("Failed to search assets: %s", err.Error())}, nil
+		}
+
+		var assetIDs []string
+		for _, result := range searchResponse.Results {
+			if result.Resource.ResourceType == "Asset" {
+				assetIDs = append(assetIDs, result.Resource.ID)
+			}
+		}
+		if len(assetIDs) == 0 {
+			return ResolveAssetOutput{Error: fmt.Sprintf("No asset found matching '%s'", name)}, nil
+		}
+
+		assets, err := clients.GetAssetsOverview(ctx, collibraClient, assetIDs)
+		if err != nil {
+			return ResolveAssetOutput{Error: fmt.Sprintf("Failed to retrieve the candidate assets: %s", err.Error())}, nil
+		}
+
+		collibraHost, ok := chip.GetCollibraHost(ctx)
+		if !ok {
+			slog.WarnContext(ctx, "Collibra instance URL unknown, links will be rendered without host")
+		}
+
+		candidates := make([]ResolvedAsset, len(assets))
+		for i, asset := range assets {
+			candidates[i] = rankAsset(asset, name, input.Context)
+			candidates[i].Link = fmt.Sprintf("%s/asset/%s", strings.TrimSuffix(collibraHost, "/"), asset.ID)
+		}
+		slices.SortStableFunc(candidates, func(a, b ResolvedAsset) int { return b.Score - a.Score })
+
+		if isConfidentMatch(candidates) {
+			return ResolveAssetOutput{Match: &candidates[0]}, nil
+		}
+		return ResolveAssetOutput{Candidates: candidates[:min(len(candidates), resolveCandidatesLimit)]}, nil
+	}
+}
+
+func isConfidentMatch(candidates []ResolvedAsset) bool {
+	if len(candidates) == 0 || candidates[0].Score < confidentScore {
+		return false
+	}
+	return len(candidates) == 1 || candidates[0].Score-candidates[1].Score >= confidentMargin
+}
+
+// rankAsset scores how well the asset matches the name and the words of the context hint.
+func rankAsset(asset clients.Asset, name string, hints string) ResolvedAsset {
+	resolved := ResolvedAsset{
+		ID:       asset.ID,
+		Name:     asset.DisplayName,
+		FullName: asset.FullName,
+		Reasons:  []string{},
+	}
+	if asset.Type != nil {
+		resolved.AssetType = asset.Type.Name
+	}
+	if asset.Domain != nil {
+		resolved.Domain = asset.Domain.Name
+		if asset.Domain.Parent != nil {
+			resolved.Community = asset.Domain.Parent.Name
+		}
+	}
+	if asset.Status != nil {
+		resolved.Status = asset.Status.Name
+	}
+
+	score := func(points int, reason string) {
+		resolved.Score += points
+		resolved.Reasons = append(resolved.Reasons, reason)
+	}
+
+	switch {
+	case strings.EqualFold(asset.DisplayName, name) || strings.EqualFold(asset.FullName, name):
+		score(50, "exact name match")
+	case strings.HasPrefix(strings.ToLower(asset.DisplayName), strings.ToLower(name)):
+		score(20, "name starts with the given name")
+	case strings.Contains(strings.ToLower(asset.DisplayName), strings.ToLower(name)):
+		score(10, "name contains the given name")
+	}
+
+	for _, hint := range strings.Fields(strings.ToLower(hints)) {
+		if len(hint) < 3 || slices.Contains(resolveStopWords, hint) {
+			continue
+		}
+		switch {
+		case strings.Contains(strings.ToLower(resolved.AssetType), hint):
+			score(15, fmt.Sprintf("asset type matches '%s'", hint))
+		case strings.Contains(strings.ToLower(resolved.Domain), hint) || strings.Contains(strings.ToLower(resolved.Community), hint):
+			score(15, fmt.Sprintf("domain or community matches '%s'", hint))
+		case strings.Contains(strings.ToLower(asset.FullName), hint):
+			score(10, fmt.Sprintf("full name path contains '%s'", hint))
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,93 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/collibra/chip/pkg/tools"
+	"github.com/google/uuid"
+)
+
+func newResolveAssetServer(t *testing.T, assets []clients.Asset) *httptest.Server {
+	tableTypeId, _ := uuid.NewUUID()
+
+	handler := http.NewServeMux()
+	handleMetamodel(handler, metamodel.Metamodel{
+		AssetTypes: []clients.AssetTypeDetails{{ID: tableTypeId.String(), Name: "Table"}},
+	})
+	handler.Handle("/rest/2.0/search", JsonHandlerInOut(func(httpRequest *http.Request, request clients.SearchRequest) (int, clients.SearchResponse) {
+		if len(request.Filters) != 1 || request.Filters[0].Values[0] != tableTypeId.String() {
+			t.Errorf("Expected the search to be filtered on the Table asset type, got: %+v", request.Filters)
+		}
+		response := clients.SearchResponse{Total: len(assets)}
+		for _, asset := range assets {
+			response.Results = append(response.Results, clients.SearchResult{
+				Resource: clients.SearchResource{ResourceType: "Asset", ID: asset.ID, Name: asset.DisplayName},
+			})
+		}
+		return http.StatusOK, response
+	}))
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(httpRequest *http.Request, request clients.Request) (int, clients.Response) {
+		return http.StatusOK, clients.Response{Data: &clients.AssetQueryData{Assets: assets}}
+	}))
+	return httptest.NewServer(handler)
+}
+
+func TestResolveAsset_ConfidentMatch(t *testing.T) {
+	server := newResolveAssetServer(t, []clients.Asset{
+		{
+			ID:          "11111111-1111-1111-1111-111111111111",
+			DisplayName: "customer_orders_archive",
+			FullName:    "hr_dwh>public>customer_orders_archive",
+			Type:        &clients.AssetType{Name: "Table"},
+			Domain:      &clients.Domain{Name: "HR DWH"},
+		},
+		{
+			ID:          "22222222-2222-2222-2222-222222222222",
+			DisplayName: "customer_orders",
+			FullName:    "sales_dwh>public>customer_orders",
+			Type:        &clients.AssetType{Name: "Table"},
+			Domain:      &clients.Domain{Name: "Sales DWH", Parent: &clients.Community{Name: "Sales"}},
+		},
+	})
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewResolveAssetTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.ResolveAssetInput{
+		Name:      "customer_orders",
+		AssetType: "table",
+		Context:   "in the sales DWH",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Match == nil {
+		t.Fatalf("Expected a confident match, got: %+v", output)
+	}
+	if output.Match.ID != "22222222-2222-2222-2222-222222222222" || output.Match.Community != "Sales" {
+		t.Errorf("Expected the sales table to match, got: %+v", output.Match)
+	}
+}
+
+func TestResolveAsset_Ambiguous(t *testing.T) {
+	server := newResolveAssetServer(t, []clients.Asset{
+		{ID: "11111111-1111-1111-1111-111111111111", DisplayName: "customer_orders", Domain: &clients.Domain{Name: "Sales DWH"}},
+		{ID: "22222222-2222-2222-2222-222222222222", DisplayName: "customer_orders", Domain: &clients.Domain{Name: "Marketing DWH"}},
+	})
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewResolveAssetTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.ResolveAssetInput{
+		Name:      "customer_orders",
+		AssetType: "Table",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Match != nil || len(output.Candidates) != 2 {
+		t.Fatalf("Expected both candidates to disambiguate, got: %+v", output)
+	}
+}
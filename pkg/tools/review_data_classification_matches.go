@@ -0,0 +1,275 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+const (
+	reviewDefaultLimit = 100
+	// reviewScanLimit bounds the number of suggested matches looked at to find those satisfying a rule.
+	reviewScanLimit    = 10000
+	reviewSearchPage   = 1000
+	reviewOverviewPage = 100
+)
+
+type ReviewDataClassificationMatchesInput struct {
+	Decision               string                         `json:"decision" jsonschema:"Required. accept or reject."`
+	ClassificationMatchIDs []string                       `json:"classificationMatchIds,omitempty" jsonschema:"The UUIDs of the classification matches to decide on. Either these or a rule is required."`
+	Rule                   *ClassificationMatchReviewRule `json:"rule,omitempty" jsonschema:"The suggested classification matches to decide on. Either this or classification match IDs is required."`
+	DryRun                 bool                           `json:"dryRun,omitempty" jsonschema:"Optional. Set to true to only list the matches that would be decided on, up to the limit. Default: false."`
+	Limit                  int                            `json:"limit,omitempty" jsonschema:"Optional. The maximum number of matches to decide on in this call, at most 500. Default: 100."`
+}
+
+// ClassificationMatchReviewRule selects suggested classification matches. All the criteria given must hold.
+type ClassificationMatchReviewRule struct {
+	MinConfidence     float64  `json:"minConfidence,omitempty" jsonschema:"Optional. Only matches with at least this confidence, between 0 and 1."`
+	MaxConfidence     float64  `json:"maxConfidence,omitempty" jsonschema:"Optional. Only matches with at most this confidence, between 0 and 1."`
+	ClassificationIDs []string `json:"classificationIds,omitempty" jsonschema:"Optional. Only matches of these data classes."`
+	AssetTypeIDs      []string `json:"assetTypeIds,omitempty" jsonschema:"Optional. Only matches of assets of these asset types."`
+	Domain            string   `json:"domain,omitempty" jsonschema:"Optional. Only matches of assets in this domain, given as a name or UUID."`
+}
+
+type ReviewedClassificationMatch struct {
+	ClassificationMatchID string  `json:"classificationMatchId" jsonschema:"The UUID of the classification match"`
+	AssetID               string  `json:"assetId,omitempty" jsonschema:"The UUID of the classified asset"`
+	AssetName             string  `json:"assetName,omitempty" jsonschema:"The name of the classified asset"`
+	Domain                string  `json:"domain,omitempty" jsonschema:"The domain of the classified asset, when the rule selects on it"`
+	ClassificationID      string  `json:"classificationId,omitempty" jsonschema:"The UUID of the data class"`
+	ClassificationName    string  `json:"classificationName,omitempty" jsonschema:"The name of the data class"`
+	Confidence            float64 `json:"confidence" jsonschema:"The confidence of the match"`
+	PreviousStatus        string  `json:"previousStatus,omitempty" jsonschema:"The status of the match before the review"`
+	Outcome               string  `json:"outcome" jsonschema:"accepted, rejected, would_accept, would_reject, skipped when the match already had the decided status, or failed"`
+	Error                 string  `json:"error,omitempty" jsonschema:"Error message if the decision failed"`
+}
+
+type ReviewDataClassificationMatchesOutput struct {
+	Matches []ReviewedClassificationMatch `json:"matches" jsonschema:"The matches decided on, or that would be on a dry run"`
+	Decided int                           `json:"decided" jsonschema:"The number of matches decided on"`
+	Skipped int                           `json:"skipped" jsonschema:"The number of matches that already had the decided status"`
+	Failed  int                           `json:"failed" jsonschema:"The number of matches whose decision failed"`
+	HasMore bool                          `json:"hasMore" jsonschema:"Whether more suggested matches satisfy the rule than the limit. Decided matches are no longer suggested, so after deciding call again to decide on the next ones. A dry run decides nothing and lists the same matches when called again; raise the limit to list more."`
+	DryRun  bool                          `json:"dryRun" jsonschema:"Whether this was a dry run and nothing was changed"`
+	Success bool                          `json:"success" jsonschema:"Whether no decision failed"`
+	Error   string                        `json:"error,omitempty" jsonschema:"Error message if the review failed as a whole"`
+}
+
+func NewReviewDataClassificationMatchesTool(collibraClient *http.Client) *chip.Tool[ReviewDataClassificationMatchesInput, ReviewDataClassificationMatchesOutput] {
+	return &chip.Tool[ReviewDataClassificationMatchesInput, ReviewDataClassificationMatchesOutput]{
+		Name:        "data_classification_match_review",
+		Description: "Accept or reject classification matches, to triage the suggestions of automatic data classification. Decide on matches by UUID, or on the suggested matches satisfying a rule such as a minimum confidence, data classes, asset types and a domain. Use dryRun to list the matches a rule selects before deciding on them.",
+		Handler:     handleReviewDataClassificationMatches(collibraClient),
+	}
+}
+
+func handleReviewDataClassificationMatches(collibraClient *http.Client) chip.ToolHandlerFunc[ReviewDataClassificationMatchesInput, ReviewDataClassificationMatchesOutput] {
+	return func(ctx context.Context, input ReviewDataClassificationMatchesInput) (ReviewDataClassificationMatchesOutput, error) {
+		decide, decidedStatus, err := reviewDecision(input.Decision)
+		if err != nil {
+			return ReviewDataClassificationMatchesOutput{Success: false, Error: err.Error()}, nil
+		}
+		if (len(input.ClassificationMatchIDs) == 0) == (input.Rule == nil) {
+			return ReviewDataClassificationMatchesOutput{Success: false, Error: "Either classification match IDs or a rule is required, not both"}, nil
+		}
+		limit := input.Limit
+		if limit <= 0 {
+			limit = reviewDefaultLimit
+		}
+		if limit > classificationMatchBulkLimit || len(input.ClassificationMatchIDs) > classificationMatchBulkLimit {
+			return ReviewDataClassificationMatchesOutput{Success: false, Error: fmt.Sprintf("At most %d matches can be decided on at once", classificationMatchBulkLimit)}, nil
+		}
+
+		output := ReviewDataClassificationMatchesOutput{DryRun: input.DryRun, Matches: []ReviewedClassificationMatch{}}
+		if input.Rule != nil {
+			output.Matches, output.HasMore, err = findMatchesByRule(ctx, collibraClient, *input.Rule, limit)
+			if err != nil {
+				return ReviewDataClassificationMatchesOutput{Success: false, Error: err.Error()}, nil
+			}
+		} else {
+			output.Matches = findMatchesByID(ctx, collibraClient, input.ClassificationMatchIDs)
+		}
+
+		err = forEachConcurrently(ctx, len(output.Matches), func(i int) {
+			match := &output.Matches[i]
+			switch {
+			case match.Outcome == "failed":
+			case match.PreviousStatus == decidedStatus:
+				match.Outcome = "skipped"
+			case input.DryRun:
+				match.Outcome = "would_" + strings.ToLower(input.Decision)
+			default:
+				if _, err := decide(ctx, collibraClient, match.ClassificationMatchID); err != nil {
+					match.Outcome, match.Error = "failed", fmt.Sprintf("Failed to %s classification match: %s", strings.ToLower(input.Decision), err.Error())
+					return
+				}
+				match.Outcome = strings.ToLower(decidedStatus)
+			}
+		})
+		if err != nil {
+			for i := range output.Matches {
+				if output.Matches[i].Outcome == "" {
+					output.Matches[i].Outcome, output.Matches[i].Error = "failed", fmt.Sprintf("Not processed: %s", err.Error())
+				}
+			}
+		}
+
+		for _, match := range output.Matches {
+			switch match.Outcome {
+			case "accepted", "rejected":
+				output.Decided++
+			case "skipped":
+				output.Skipped++
+			case "failed":
+				output.Failed++
+			}
+		}
+		output.Success = output.Failed == 0
+		return output, nil
+	}
+}
+
+func reviewDecision(decision string) (func(context.Context, *http.Client, string) (*clients.DataClassificationMatch, error), string, error) {
+	switch strings.ToLower(strings.TrimSpace(decision)) {
+	case "accept":
+		return clients.AcceptDataClassificationMatch, "ACCEPTED", nil
+	case "reject":
+		return clients.RejectDataClassificationMatch, "REJECTED", nil
+	default:
+		return nil, "", fmt.Errorf("invalid decision '%s', expected accept or reject", decision)
+	}
+}
+
+func reviewedMatch(match clients.DataClassificationMatch) ReviewedClassificationMatch {
+	return ReviewedClassificationMatch{
+		ClassificationMatchID: match.ID,
+		AssetID:               match.Asset.ID,
+		AssetName:             match.Asset.Name,
+		ClassificationID:      match.Classification.ID,
+		ClassificationName:    match.Classification.Name,
+		Confidence:            match.Confidence,
+		PreviousStatus:        match.Status,
+	}
+}
+
+func findMatchesByID(ctx context.Context, collibraClient *http.Client, ids []string) []ReviewedClassificationMatch {
+	seen := map[string]bool{}
+	var unique []string
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	ids = unique
+
+	matches := make([]ReviewedClassificationMatch, len(ids))
+	err := forEachConcurrently(ctx, len(ids), func(i int) {
+		if ids[i] == "" {
+			matches[i] = ReviewedClassificationMatch{Outcome: "failed", Error: "Classification match ID is required"}
+			return
+		}
+		match, err := clients.GetDataClassificationMatch(ctx, collibraClient, ids[i])
+		if err != nil {
+			matches[i] = ReviewedClassificationMatch{ClassificationMatchID: ids[i], Outcome: "failed", Error: fmt.Sprintf("Failed to retrieve classification match: %s", err.Error())}
+			return
+		}
+		matches[i] = reviewedMatch(*match)
+	})
+	if err != nil {
+		for i := range matches {
+			if matches[i].ClassificationMatchID == "" && matches[i].Outcome == "" {
+				matches[i] = ReviewedClassificationMatch{ClassificationMatchID: ids[i], Outcome: "failed", Error: fmt.Sprintf("Not processed: %s", err.Error())}
+			}
+		}
+	}
+	return matches
+}
+
+// findMatchesByRule pages through the suggested matches until limit matches satisfy the rule. It reports whether more
+// matches satisfy it.
+func findMatchesByRule(ctx context.Context, collibraClient *http.Client, rule ClassificationMatchReviewRule, limit int) ([]ReviewedClassificationMatch, bool, error) {
+	if rule.MinConfidence < 0 || rule.MinConfidence > 1 || rule.MaxConfidence < 0 || rule.MaxConfidence > 1 {
+		return nil, false, fmt.Errorf("confidence must be between 0 and 1")
+	}
+
+	matches := []ReviewedClassificationMatch{}
+	pageLimit := reviewSearchPage
+	for offset := 0; offset < reviewScanLimit; offset += reviewSearchPage {
+		page, total, err := clients.SearchDataClassificationMatches(ctx, collibraClient, clients.DataClassificationMatchQueryParams{
+			Statuses:          []string{"SUGGESTED"},
+			ClassificationIDs: rule.ClassificationIDs,
+			AssetTypeIDs:      rule.AssetTypeIDs,
+			Limit:             &pageLimit,
+			Offset:            &offset,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to search classification matches: %w", err)
+		}
+
+		var candidates []ReviewedClassificationMatch
+		for _, match := range page {
+			if rule.MinConfidence > 0 && match.Confidence < rule.MinConfidence {
+				continue
+			}
+			if rule.MaxConfidence > 0 && match.Confidence > rule.MaxConfidence {
+				continue
+			}
+			candidates = append(candidates, reviewedMatch(match))
+		}
+		if rule.Domain != "" {
+			if candidates, err = filterMatchesByDomain(ctx, collibraClient, candidates, rule.Domain); err != nil {
+				return nil, false, err
+			}
+		}
+
+		for _, candidate := range candidates {
+			if len(matches) == limit {
+				return matches, true, nil
+			}
+			matches = append(matches, candidate)
+		}
+		if len(page) < reviewSearchPage || int64(offset+len(page)) >= total {
+			break
+		}
+	}
+	return matches, false, nil
+}
+
+func filterMatchesByDomain(ctx context.Context, collibraClient *http.Client, matches []ReviewedClassificationMatch, domain string) ([]ReviewedClassificationMatch, error) {
+	_, err := uuid.Parse(domain)
+	isID := err == nil
+	var filtered []ReviewedClassificationMatch
+	for start := 0; start < len(matches); start += reviewOverviewPage {
+		batch := matches[start:min(start+reviewOverviewPage, len(matches))]
+		assetIDs := make([]string, len(batch))
+		for i, match := range batch {
+			assetIDs[i] = match.AssetID
+		}
+		assets, err := clients.GetAssetsOverview(ctx, collibraClient, assetIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the domains of the classified assets: %w", err)
+		}
+
+		domains := map[string]*clients.Domain{}
+		for _, asset := range assets {
+			domains[asset.ID] = asset.Domain
+		}
+		for _, match := range batch {
+			assetDomain := domains[match.AssetID]
+			if assetDomain == nil {
+				continue
+			}
+			if (isID && assetDomain.ID == domain) || (!isID && strings.EqualFold(assetDomain.Name, domain)) {
+				match.Domain = assetDomain.Name
+				filtered = append(filtered, match)
+			}
+		}
+	}
+	return filtered, nil
+}
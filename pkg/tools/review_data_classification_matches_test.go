@@ -0,0 +1,118 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestReviewDataClassificationMatches_ByRule(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	columns := columnIDs(4)
+	confident := fake.suggest(columns[0], emailClassID, 0.95)
+	doubtful := fake.suggest(columns[1], emailClassID, 0.5)
+	otherDomain := fake.suggest(columns[2], emailClassID, 0.97)
+	alsoConfident := fake.suggest(columns[3], emailClassID, 0.92)
+
+	handler := fake.handler()
+	handler.Handle("/graphql/knowledgeGraph/v1", JsonHandlerInOut(func(_ *http.Request, _ clients.Request) (int, clients.Response) {
+		sales := &clients.Domain{ID: "eeeeeeee-0000-0000-0000-000000000001", Name: "Sales DWH"}
+		hr := &clients.Domain{ID: "eeeeeeee-0000-0000-0000-000000000002", Name: "HR DWH"}
+		return http.StatusOK, clients.Response{Data: &clients.AssetQueryData{Assets: []clients.Asset{
+			{ID: columns[0], Domain: sales},
+			{ID: columns[1], Domain: sales},
+			{ID: columns[2], Domain: hr},
+			{ID: columns[3], Domain: sales},
+		}}}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewReviewDataClassificationMatchesTool(newClient(server))
+	input := tools.ReviewDataClassificationMatchesInput{
+		Decision: "accept",
+		Rule:     &tools.ClassificationMatchReviewRule{MinConfidence: 0.9, Domain: "sales dwh"},
+		DryRun:   true,
+		Limit:    1,
+	}
+	output, err := tool.Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.DryRun || len(output.Matches) != 1 || !output.HasMore {
+		t.Fatalf("Expected one match listed with more to come, got: %+v", output)
+	}
+	if output.Matches[0].ClassificationMatchID != confident || output.Matches[0].Outcome != "would_accept" || output.Matches[0].Domain != "Sales DWH" {
+		t.Errorf("Unexpected match: %+v", output.Matches[0])
+	}
+	if fake.status(confident) != "SUGGESTED" {
+		t.Errorf("Expected a dry run not to change anything")
+	}
+
+	input.DryRun, input.Limit = false, 0
+	output, err = tool.Handler(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Decided != 2 || output.HasMore {
+		t.Fatalf("Expected both confident sales matches accepted, got: %+v", output)
+	}
+	for id, expected := range map[string]string{confident: "ACCEPTED", alsoConfident: "ACCEPTED", doubtful: "SUGGESTED", otherDomain: "SUGGESTED"} {
+		if status := fake.status(id); status != expected {
+			t.Errorf("Expected match %s to be %s, got %s", id, expected, status)
+		}
+	}
+}
+
+func TestReviewDataClassificationMatches_ByID(t *testing.T) {
+	fake := newFakeClassificationMatches()
+	columns := columnIDs(3)
+	suggested := fake.suggest(columns[0], emailClassID, 0.4)
+	rejected := fake.suggest(columns[1], emailClassID, 0.3)
+	failing := fake.suggest(columns[2], emailClassID, 0.2)
+	fake.matches[rejected] = func() clients.DataClassificationMatch {
+		match := fake.matches[rejected]
+		match.Status = "REJECTED"
+		return match
+	}()
+	fake.failingMatchID = failing
+	server := fake.server()
+	defer server.Close()
+
+	output, err := tools.NewReviewDataClassificationMatchesTool(newClient(server)).Handler(t.Context(), tools.ReviewDataClassificationMatchesInput{
+		Decision:               "reject",
+		ClassificationMatchIDs: []string{suggested, rejected, failing, " " + suggested + " "},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(output.Matches) != 3 {
+		t.Errorf("Expected the repeated match to be reviewed once, got: %+v", output.Matches)
+	}
+	if output.Success || output.Decided != 1 || output.Skipped != 1 || output.Failed != 1 {
+		t.Fatalf("Expected one rejected, one skipped and one failed match, got: %+v", output)
+	}
+	if fake.status(suggested) != "REJECTED" {
+		t.Errorf("Expected the suggested match to be rejected")
+	}
+}
+
+func TestReviewDataClassificationMatches_InvalidInput(t *testing.T) {
+	tool := tools.NewReviewDataClassificationMatchesTool(&http.Client{})
+	for _, input := range []tools.ReviewDataClassificationMatchesInput{
+		{Decision: "approve", ClassificationMatchIDs: []string{"bbbbbbbb-0000-0000-0000-000000000001"}},
+		{Decision: "accept"},
+		{Decision: "accept", ClassificationMatchIDs: []string{"bbbbbbbb-0000-0000-0000-000000000001"}, Rule: &tools.ClassificationMatchReviewRule{}},
+	} {
+		output, err := tool.Handler(t.Context(), input)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if output.Success || output.Error == "" {
+			t.Errorf("Expected %+v to be rejected, got: %+v", input, output)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type StartWorkflowInput struct {
+	WorkflowDefinitionID string            `json:"workflowDefinitionId" jsonschema:"Required. The UUID of the workflow definition to start, as returned by workflow_definitions_list."`
+	AssetIDs             []string          `json:"assetIds,omitempty" jsonschema:"Optional. The UUIDs of the assets to start the workflow on. Leave empty for global workflows."`
+	FormProperties       map[string]string `json:"formProperties,omitempty" jsonschema:"Optional. The values of the start form, keyed by form property ID. Enumeration values can be given by ID or name."`
+}
+
+type StartWorkflowOutput struct {
+	Instances        []clients.WorkflowInstance `json:"instances,omitempty" jsonschema:"The started workflow instances"`
+	Success          bool                       `json:"success" jsonschema:"Whether the workflow was successfully started"`
+	Error            string                     `json:"error,omitempty" jsonschema:"Error message if the operation failed"`
+	ValidationErrors []string                   `json:"validationErrors,omitempty" jsonschema:"The problems found in the provided form properties"`
+	FormProperties   []WorkflowFormProperty     `json:"formProperties,omitempty" jsonschema:"The form properties of the workflow, returned when the provided values are invalid"`
+}
+
+type WorkflowFormProperty struct {
+	ID            string   `json:"id" jsonschema:"The ID of the form property, used as key in formProperties"`
+	Name          string   `json:"name" jsonschema:"The label of the form property"`
+	Type          string   `json:"type" jsonschema:"The type of the form property (e.g., string, boolean, enum, user, date)"`
+	Required      bool     `json:"required" jsonschema:"Whether a value is required"`
+	Value         string   `json:"value,omitempty" jsonschema:"The default value of the form property"`
+	AllowedValues []string `json:"allowedValues,omitempty" jsonschema:"The allowed values of an enumeration form property"`
+}
+
+func NewStartWorkflowTool(collibraClient *http.Client) *chip.Tool[StartWorkflowInput, StartWorkflowOutput] {
+	return &chip.Tool[StartWorkflowInput, StartWorkflowOutput]{
+		Name:        "workflow_start",
+		Description: "Start a Collibra workflow (e.g., an access request or certification) on one or more assets. The form properties are validated against the workflow's start form before starting; when invalid, the expected form properties are returned.",
+		Handler:     handleStartWorkflow(collibraClient),
+	}
+}
+
+func handleStartWorkflow(collibraClient *http.Client) chip.ToolHandlerFunc[StartWorkflowInput, StartWorkflowOutput] {
+	return func(ctx context.Context, input StartWorkflowInput) (StartWorkflowOutput, error) {
+		if _, err := uuid.Parse(input.WorkflowDefinitionID); err != nil {
+			return StartWorkflowOutput{Success: false, Error: fmt.Sprintf("Invalid workflow definition ID format: %s", err.Error())}, nil
+		}
+		for _, assetID := range input.AssetIDs {
+			if _, err := uuid.Parse(assetID); err != nil {
+				return StartWorkflowOutput{Success: false, Error: fmt.Sprintf("Invalid asset ID format '%s': %s", assetID, err.Error())}, nil
+			}
+		}
+
+		formData, err := clients.GetWorkflowStartFormData(ctx, collibraClient, input.WorkflowDefinitionID)
+		if err != nil {
+			return StartWorkflowOutput{Success: false, Error: fmt.Sprintf("Failed to retrieve start form: %s", err.Error())}, nil
+		}
+
+		formProperties, validationErrors := validateFormProperties(formData, input.FormProperties)
+		if len(validationErrors) > 0 {
+			return StartWorkflowOutput{
+				Success:          false,
+				Error:            "The form properties are invalid",
+				ValidationErrors: validationErrors,
+				FormProperties:   describeFormProperties(formData),
+			}, nil
+		}
+
+		request := clients.StartWorkflowInstancesRequest{
+			WorkflowDefinitionID: input.WorkflowDefinitionID,
+			BusinessItemIDs:      input.AssetIDs,
+			FormProperties:       formProperties,
+			SendNotification:     true,
+		}
+		if len(input.AssetIDs) > 0 {
+			request.BusinessItemType = "ASSET"
+		}
+
+		instances, err := clients.StartWorkflowInstances(ctx, collibraClient, request)
+		if err != nil {
+			return StartWorkflowOutput{Success: false, Error: fmt.Sprintf("Failed to start workflow: %s", err.Error())}, nil
+		}
+
+		return StartWorkflowOutput{
+			Instances: instances,
+			Success:   true,
+		}, nil
+	}
+}
+
+// validateFormProperties checks the provided values against the form and returns them with enumeration names
+// replaced by their IDs.
+func validateFormProperties(formData *clients.FormData, values map[string]string) (map[string]string, []string) {
+	var validationErrors []string
+	normalized := map[string]string{}
+
+	known := map[string]clients.FormProperty{}
+	for _, property := range formData.FormProperties {
+		known[property.ID] = property
+	}
+
+	for id, value := range values {
+		property, ok := known[id]
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Sprintf("unknown form property '%s'", id))
+			continue
+		}
+		if !property.Writable {
+			validationErrors = append(validationErrors, fmt.Sprintf("form property '%s' is read-only", id))
+			continue
+		}
+
+		switch property.Type {
+		case "enum":
+			enumID, ok := matchEnumValue(property.EnumValues, value)
+			if !ok {
+				validationErrors = append(validationErrors, fmt.Sprintf("invalid value '%s' for form property '%s', allowed values: %s", value, id, strings.Join(enumValueNames(property.EnumValues), ", ")))
+				continue
+			}
+			value = enumID
+		case "boolean":
+			if value != "true" && value != "false" {
+				validationErrors = append(validationErrors, fmt.Sprintf("invalid value '%s' for form property '%s', expected true or false", value, id))
+				continue
+			}
+		}
+		normalized[id] = value
+	}
+
+	for _, property := range formData.FormProperties {
+		_, provided := values[property.ID]
+		if property.Required && property.Writable && !provided && property.Value == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf("missing required form property '%s' (%s)", property.ID, property.Name))
+		}
+	}
+
+	slices.Sort(validationErrors)
+	return normalized, validationErrors
+}
+
+func matchEnumValue(enumValues []clients.FormEnumValue, value string) (string, bool) {
+	for _, enumValue := range enumValues {
+		if enumValue.ID == value || strings.EqualFold(enumValue.Name, value) {
+			return enumValue.ID, true
+		}
+	}
+	return "", false
+}
+
+func enumValueNames(enumValues []clients.FormEnumValue) []string {
+	names := make([]string, len(enumValues))
+	for i, enumValue := range enumValues {
+		names[i] = enumValue.Name
+	}
+	return names
+}
+
+func describeFormProperties(formData *clients.FormData) []WorkflowFormProperty {
+	var properties []WorkflowFormProperty
+	for _, property := range formData.FormProperties {
+		if !property.Writable {
+			continue
+		}
+		properties = append(properties, WorkflowFormProperty{
+			ID:            property.ID,
+			Name:          property.Name,
+			Type:          property.Type,
+			Required:      property.Required,
+			Value:         property.Value,
+			AllowedValues: enumValueNames(property.EnumValues),
+		})
+	}
+	return properties
+}
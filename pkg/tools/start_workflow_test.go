@@ -0,0 +1,104 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+const workflowDefinitionId = "3c0f0b8e-52a4-4bb5-8c9d-ecc3b4a4e7a1"
+
+func newStartFormHandler(t *testing.T, started *clients.StartWorkflowInstancesRequest) *http.ServeMux {
+	handler := http.NewServeMux()
+	handler.Handle("/rest/2.0/workflowDefinitions/"+workflowDefinitionId+"/startFormData", JsonHandlerOut(func(r *http.Request) (int, clients.FormData) {
+		return http.StatusOK, clients.FormData{
+			FormProperties: []clients.FormProperty{
+				{ID: "reason", Name: "Reason", Type: "string", Required: true, Writable: true},
+				{ID: "accessLevel", Name: "Access level", Type: "enum", Required: true, Writable: true, EnumValues: []clients.FormEnumValue{
+					{ID: "read", Name: "Read only"},
+					{ID: "write", Name: "Read and write"},
+				}},
+			},
+		}
+	}))
+	handler.Handle("POST /rest/2.0/workflowInstances", JsonHandlerInOut(func(r *http.Request, request clients.StartWorkflowInstancesRequest) (int, []clients.WorkflowInstance) {
+		*started = request
+		return http.StatusCreated, []clients.WorkflowInstance{
+			{ID: "instance-1", WorkflowDefinition: clients.NamedResourceReference{ID: request.WorkflowDefinitionID, Name: "Request Access"}},
+		}
+	}))
+	return handler
+}
+
+func TestStartWorkflow(t *testing.T) {
+	var started clients.StartWorkflowInstancesRequest
+	server := httptest.NewServer(newStartFormHandler(t, &started))
+	defer server.Close()
+
+	output, err := tools.NewStartWorkflowTool(newClient(server)).Handler(t.Context(), tools.StartWorkflowInput{
+		WorkflowDefinitionID: workflowDefinitionId,
+		AssetIDs:             []string{"9179b887-04ef-4ce5-ab3a-b5bbd39ea3c8"},
+		FormProperties: map[string]string{
+			"reason":      "Quarterly sales analysis",
+			"accessLevel": "read only",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success {
+		t.Fatalf("Expected success, got: %+v", output)
+	}
+	if len(output.Instances) != 1 {
+		t.Fatalf("Expected 1 started instance, got: %d", len(output.Instances))
+	}
+	if started.BusinessItemType != "ASSET" || len(started.BusinessItemIDs) != 1 {
+		t.Errorf("Expected the workflow to be started on the asset, got: %+v", started)
+	}
+	if started.FormProperties["accessLevel"] != "read" {
+		t.Errorf("Expected the enumeration name to be replaced by its ID, got: '%s'", started.FormProperties["accessLevel"])
+	}
+}
+
+func TestStartWorkflow_InvalidFormProperties(t *testing.T) {
+	var started clients.StartWorkflowInstancesRequest
+	server := httptest.NewServer(newStartFormHandler(t, &started))
+	defer server.Close()
+
+	output, err := tools.NewStartWorkflowTool(newClient(server)).Handler(t.Context(), tools.StartWorkflowInput{
+		WorkflowDefinitionID: workflowDefinitionId,
+		FormProperties: map[string]string{
+			"accessLevel": "admin",
+			"priority":    "high",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success {
+		t.Fatalf("Expected the workflow not to be started")
+	}
+	if started.WorkflowDefinitionID != "" {
+		t.Fatalf("Expected no workflow instance to be started")
+	}
+
+	expected := []string{
+		"invalid value 'admin' for form property 'accessLevel', allowed values: Read only, Read and write",
+		"missing required form property 'reason' (Reason)",
+		"unknown form property 'priority'",
+	}
+	if len(output.ValidationErrors) != len(expected) {
+		t.Fatalf("Expected %d validation errors, got: %v", len(expected), output.ValidationErrors)
+	}
+	for i, validationError := range output.ValidationErrors {
+		if validationError != expected[i] {
+			t.Errorf("Expected validation error '%s', got: '%s'", expected[i], validationError)
+		}
+	}
+	if len(output.FormProperties) != 2 {
+		t.Errorf("Expected the form properties to be described, got: %+v", output.FormProperties)
+	}
+}
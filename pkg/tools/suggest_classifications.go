@@ -0,0 +1,166 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/classification"
+	"github.com/collibra/chip/pkg/clients"
+)
+
+const (
+	suggestDataClassPageSize   = 1000
+	suggestMaxDataClasses      = 10000
+	suggestDefaultLimit        = 5
+	suggestMaxLimit            = 50
+	suggestMaxSampleValueCount = 1000
+)
+
+type SuggestClassificationsInput struct {
+	ColumnName string   `json:"columnName" jsonschema:"Required. The name of the column."`
+	DataType   string   `json:"dataType,omitempty" jsonschema:"Optional. The data type of the column, e.g. VARCHAR. When empty, the column type filters of the data classes are not applied."`
+	Values     []string `json:"values,omitempty" jsonschema:"Optional. A sample of the column values, at most 1000, pasted by the user or read from a local file. Use empty strings for null or empty values."`
+	Limit      int      `json:"limit,omitempty" jsonschema:"Optional. Maximum number of candidate data classes to return. The maximum value is 50. Default: 5."`
+}
+
+type SuggestClassificationsOutput struct {
+	Candidates []ClassificationCandidate `json:"candidates" jsonschema:"The candidate data classes, best first: those that match before those that only partially match, then by confidence"`
+	Evaluated  int                       `json:"evaluated" jsonschema:"The number of data classes whose rules were evaluated"`
+	Skipped    []string                  `json:"skipped,omitempty" jsonschema:"Data classes that could not be evaluated, with the reason"`
+	Error      string                    `json:"error,omitempty" jsonschema:"HTTP or other error message if the request failed"`
+}
+
+type ClassificationCandidate struct {
+	DataClassID string                      `json:"dataClassId" jsonschema:"The UUID of the data class, to use as classificationId with data_classification_match_add"`
+	Name        string                      `json:"name" jsonschema:"The name of the data class"`
+	Description string                      `json:"description,omitempty" jsonschema:"The description of the data class"`
+	Matched     bool                        `json:"matched" jsonschema:"Whether the rules of the data class classify the column"`
+	Confidence  int                         `json:"confidence" jsonschema:"The highest percentage of values, or 100 for a column name match, matched by a rule"`
+	Threshold   int                         `json:"threshold" jsonschema:"The percentage of values a rule must match to fire"`
+	Evidence    []classification.RuleResult `json:"evidence" jsonschema:"The rules that matched the column name or some values, with matching examples"`
+}
+
+func NewSuggestClassificationsTool(collibraClient *http.Client) *chip.Tool[SuggestClassificationsInput, SuggestClassificationsOutput] {
+	return &chip.Tool[SuggestClassificationsInput, SuggestClassificationsOutput]{
+		Name:        "classification_suggest",
+		Description: "Suggest data classes for a column from its name, data type and a sample of its values. The rules of the data classes in Collibra are evaluated locally against the sample; the candidates are ranked and come with the rules that matched as evidence. Use a matching candidate with data_classification_match_add to classify the column asset.",
+		Handler:     handleSuggestClassifications(collibraClient),
+	}
+}
+
+func handleSuggestClassifications(collibraClient *http.Client) chip.ToolHandlerFunc[SuggestClassificationsInput, SuggestClassificationsOutput] {
+	return func(ctx context.Context, input SuggestClassificationsInput) (SuggestClassificationsOutput, error) {
+		input.ColumnName = strings.TrimSpace(input.ColumnName)
+		if input.ColumnName == "" {
+			return SuggestClassificationsOutput{Error: "Column name is required"}, nil
+		}
+		if len(input.Values) > suggestMaxSampleValueCount {
+			return SuggestClassificationsOutput{Error: fmt.Sprintf("Too many sample values: %d, the maximum is %d", len(input.Values), suggestMaxSampleValueCount)}, nil
+		}
+		if input.Limit <= 0 {
+			input.Limit = suggestDefaultLimit
+		}
+		input.Limit = min(input.Limit, suggestMaxLimit)
+
+		dataClasses, err := fetchDataClassesWithRules(ctx, collibraClient)
+		if err != nil {
+			return SuggestClassificationsOutput{Error: err.Error()}, nil
+		}
+
+		column := classification.Column{Name: input.ColumnName, DataType: input.DataType, Values: input.Values}
+		output := SuggestClassificationsOutput{Candidates: []ClassificationCandidate{}}
+		for _, dataClass := range dataClasses {
+			result, err := evaluateDataClass(dataClass, column)
+			if err != nil {
+				output.Skipped = append(output.Skipped, fmt.Sprintf("%s: %s", dataClass.Name, err.Error()))
+				continue
+			}
+			output.Evaluated++
+			if candidate, ok := classificationCandidate(dataClass, result); ok {
+				output.Candidates = append(output.Candidates, candidate)
+			}
+		}
+
+		slices.SortStableFunc(output.Candidates, func(a, b ClassificationCandidate) int {
+			if a.Matched != b.Matched {
+				if a.Matched {
+					return -1
+				}
+				return 1
+			}
+			return cmp.Or(
+				cmp.Compare(b.Confidence, a.Confidence),
+				cmp.Compare(len(b.Evidence), len(a.Evidence)),
+				strings.Compare(a.Name, b.Name),
+			)
+		})
+		if len(output.Candidates) > input.Limit {
+			output.Candidates = output.Candidates[:input.Limit]
+		}
+		return output, nil
+	}
+}
+
+func evaluateDataClass(dataClass clients.DataClass, column classification.Column) (classification.Result, error) {
+	class, err := classification.FromDataClass(dataClass)
+	if err != nil {
+		return classification.Result{}, err
+	}
+	classifier, err := classification.Compile(class)
+	if err != nil {
+		return classification.Result{}, err
+	}
+	return classifier.Evaluate(column), nil
+}
+
+// classificationCandidate keeps a data class as a candidate when its filters let the column through and at least
+// one of its rules matched something.
+func classificationCandidate(dataClass clients.DataClass, result classification.Result) (ClassificationCandidate, bool) {
+	if result.Excluded != "" {
+		return ClassificationCandidate{}, false
+	}
+	var evidence []classification.RuleResult
+	for _, rule := range result.Rules {
+		if rule.Matched > 0 {
+			evidence = append(evidence, rule)
+		}
+	}
+	if len(evidence) == 0 {
+		return ClassificationCandidate{}, false
+	}
+	return ClassificationCandidate{
+		DataClassID: dataClass.ID,
+		Name:        dataClass.Name,
+		Description: dataClass.Description,
+		Matched:     result.Matched,
+		Confidence:  result.Confidence,
+		Threshold:   result.Threshold,
+		Evidence:    evidence,
+	}, true
+}
+
+func fetchDataClassesWithRules(ctx context.Context, collibraClient *http.Client) ([]clients.DataClass, error) {
+	containsRules := true
+	limit := suggestDataClassPageSize
+	var dataClasses []clients.DataClass
+	for offset := 0; offset < suggestMaxDataClasses; offset += limit {
+		page, total, err := clients.SearchDataClasses(ctx, collibraClient, clients.DataClassQueryParams{
+			ContainsRules: &containsRules,
+			Limit:         &limit,
+			Offset:        &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		dataClasses = append(dataClasses, page...)
+		if len(page) < limit || len(dataClasses) >= total {
+			break
+		}
+	}
+	return dataClasses, nil
+}
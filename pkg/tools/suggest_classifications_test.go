@@ -0,0 +1,77 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func dataClassWithRules(id string, name string, rules ...string) clients.DataClass {
+	dataClass := clients.DataClass{ID: id, Name: name}
+	for _, rule := range rules {
+		dataClass.Rules = append(dataClass.Rules, json.RawMessage(rule))
+	}
+	return dataClass
+}
+
+func TestSuggestClassifications(t *testing.T) {
+	dataClasses := []clients.DataClass{
+		dataClassWithRules("cccccccc-0000-0000-0000-000000000001", "Phone Number", `{"type":"REGEX","pattern":"\\+?[0-9 ]{8,}"}`),
+		dataClassWithRules("cccccccc-0000-0000-0000-000000000002", "Email Address",
+			`{"type":"REGEX","pattern":"[\\w.+-]+@[\\w-]+\\.[\\w.]+"}`, `{"type":"COLUMN_NAME","pattern":".*mail.*"}`),
+		dataClassWithRules("cccccccc-0000-0000-0000-000000000003", "Broken", `{"type":"REGEX","pattern":"[a-"}`),
+		dataClassWithRules("cccccccc-0000-0000-0000-000000000004", "Domain Name", `{"type":"REGEX","pattern":"[\\w-]+\\.(com|org)"}`),
+	}
+	dataClasses[3].ConfidenceThreshold = 60
+
+	var queries []string
+	handler := http.NewServeMux()
+	handler.Handle("/rest/classification/v1/dataClasses", JsonHandlerOut(func(r *http.Request) (int, clients.DataClassesResponse) {
+		queries = append(queries, r.URL.Query().Encode())
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		return http.StatusOK, clients.DataClassesResponse{Total: len(dataClasses), Results: dataClasses[min(offset, len(dataClasses)):]}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	output, err := tools.NewSuggestClassificationsTool(newClient(server)).Handler(t.Context(), tools.SuggestClassificationsInput{
+		ColumnName: "contact",
+		DataType:   "VARCHAR",
+		Values:     []string{"ann@example.com", "bob@example.org", "example.com", "carl@example.net", "dave@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error != "" || output.Evaluated != 3 || len(output.Skipped) != 1 {
+		t.Fatalf("Expected 3 data classes evaluated and the broken one skipped, got: %+v", output)
+	}
+	if len(queries) != 1 || queries[0] != "containsRules=true&limit=1000&offset=0" {
+		t.Errorf("Expected a single query for data classes with rules, got: %v", queries)
+	}
+
+	if len(output.Candidates) != 2 {
+		t.Fatalf("Expected 2 candidates, got: %+v", output.Candidates)
+	}
+	email, domain := output.Candidates[0], output.Candidates[1]
+	if email.Name != "Email Address" || !email.Matched || email.Confidence != 80 || len(email.Evidence) != 1 {
+		t.Errorf("Expected Email Address to match first, got: %+v", email)
+	}
+	if domain.Name != "Domain Name" || domain.Matched || domain.Confidence != 20 || domain.Evidence[0].Examples[0] != "example.com" {
+		t.Errorf("Expected Domain Name to follow as a partial match, got: %+v", domain)
+	}
+}
+
+func TestSuggestClassifications_RequiresColumnName(t *testing.T) {
+	output, err := tools.NewSuggestClassificationsTool(&http.Client{}).Handler(t.Context(), tools.SuggestClassificationsInput{Values: []string{"x"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error == "" {
+		t.Errorf("Expected a missing column name to be reported")
+	}
+}
@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/classification"
+)
+
+type TestDataClassRulesInput struct {
+	DataClass DataClassDefinition `json:"dataClass" jsonschema:"Required. The data class to test, in the same format as for data_class_create. The name is optional."`
+	Columns   []ColumnSample      `json:"columns" jsonschema:"Required. The sample columns to test the rules against."`
+}
+
+type ColumnSample struct {
+	ColumnName string   `json:"columnName" jsonschema:"Required. The name of the column."`
+	DataType   string   `json:"dataType,omitempty" jsonschema:"Optional. The data type of the column, e.g. VARCHAR. When empty, the column type filters are not applied."`
+	Values     []string `json:"values,omitempty" jsonschema:"Optional. Sample values of the column. Use empty strings for null or empty values."`
+}
+
+type TestDataClassRulesOutput struct {
+	Columns []DataClassRuleTestResult `json:"columns" jsonschema:"The outcome for each sample column, in the order given"`
+	Matched int                       `json:"matched" jsonschema:"The number of sample columns the data class matches"`
+	Error   string                    `json:"error,omitempty" jsonschema:"Error message if the rules could not be tested, e.g. an invalid pattern"`
+}
+
+type DataClassRuleTestResult struct {
+	ColumnName string                      `json:"columnName" jsonschema:"The name of the column"`
+	Matched    bool                        `json:"matched" jsonschema:"Whether the column would be classified with the data class"`
+	Confidence int                         `json:"confidence" jsonschema:"The highest percentage of values, or 100 for a column name match, matched by a rule"`
+	Threshold  int                         `json:"threshold" jsonschema:"The percentage of values a rule must match to fire"`
+	Excluded   string                      `json:"excluded,omitempty" jsonschema:"Why the column filters exclude the column, if they do"`
+	Rules      []classification.RuleResult `json:"rules" jsonschema:"The outcome of each rule: whether it fired, how many values it matched out of how many evaluated, and matching examples"`
+}
+
+func NewTestDataClassRulesTool() *chip.Tool[TestDataClassRulesInput, TestDataClassRulesOutput] {
+	return &chip.Tool[TestDataClassRulesInput, TestDataClassRulesOutput]{
+		Name:        "data_class_rule_test",
+		Description: "Test the rules of a data class against sample column names and values, locally and without calling Collibra. Reports for each column whether the data class matches and which rules fire, so that rules can be tuned before data_class_create or data_class_update.",
+		Handler:     handleTestDataClassRules(),
+	}
+}
+
+func handleTestDataClassRules() chip.ToolHandlerFunc[TestDataClassRulesInput, TestDataClassRulesOutput] {
+	return func(ctx context.Context, input TestDataClassRulesInput) (TestDataClassRulesOutput, error) {
+		if len(input.Columns) == 0 {
+			return TestDataClassRulesOutput{Error: "At least one sample column is required"}, nil
+		}
+		if len(input.DataClass.Rules) == 0 {
+			return TestDataClassRulesOutput{Error: "The data class has no rules to test"}, nil
+		}
+		classifier, err := classification.Compile(input.DataClass.class())
+		if err != nil {
+			return TestDataClassRulesOutput{Error: err.Error()}, nil
+		}
+
+		output := TestDataClassRulesOutput{Columns: make([]DataClassRuleTestResult, 0, len(input.Columns))}
+		for i, column := range input.Columns {
+			if column.ColumnName == "" && len(column.Values) == 0 {
+				return TestDataClassRulesOutput{Error: fmt.Sprintf("Sample column %d has neither a name nor values", i+1)}, nil
+			}
+			result := classifier.Evaluate(classification.Column{Name: column.ColumnName, DataType: column.DataType, Values: column.Values})
+			if result.Matched {
+				output.Matched++
+			}
+			output.Columns = append(output.Columns, DataClassRuleTestResult{
+				ColumnName: column.ColumnName,
+				Matched:    result.Matched,
+				Confidence: result.Confidence,
+				Threshold:  result.Threshold,
+				Excluded:   result.Excluded,
+				Rules:      result.Rules,
+			})
+		}
+		return output, nil
+	}
+}
@@ -0,0 +1,54 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestTestDataClassRules(t *testing.T) {
+	tool := tools.NewTestDataClassRulesTool()
+	output, err := tool.Handler(t.Context(), tools.TestDataClassRulesInput{
+		DataClass: tools.DataClassDefinition{
+			ColumnTypeFilters: []string{"VARCHAR"},
+			Rules: []clients.DataClassRule{
+				{Name: "email", Type: clients.DataClassRuleTypeRegex, Pattern: `[\w.+-]+@[\w-]+\.[\w.]+`},
+				{Name: "column", Type: clients.DataClassRuleTypeColumnName, Pattern: `.*mail.*`},
+			},
+		},
+		Columns: []tools.ColumnSample{
+			{ColumnName: "contact", DataType: "varchar", Values: []string{"ann@example.com", "bob@example.org"}},
+			{ColumnName: "email", DataType: "INTEGER", Values: []string{"1", "2"}},
+			{ColumnName: "notes", Values: []string{"call ann@example.com", "no"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error != "" || output.Matched != 1 || len(output.Columns) != 3 {
+		t.Fatalf("Expected only the first column to match, got: %+v", output)
+	}
+	if contact := output.Columns[0]; !contact.Matched || contact.Confidence != 100 || !contact.Rules[0].Fired || contact.Rules[1].Fired {
+		t.Errorf("Expected the value rule to fire on contact, got: %+v", contact)
+	}
+	if email := output.Columns[1]; email.Matched || email.Excluded == "" || !email.Rules[1].Fired {
+		t.Errorf("Expected email to be excluded by its data type, got: %+v", email)
+	}
+	if notes := output.Columns[2]; notes.Matched || notes.Rules[0].Matched != 0 {
+		t.Errorf("Expected patterns to match whole values only, got: %+v", notes)
+	}
+}
+
+func TestTestDataClassRules_InvalidRule(t *testing.T) {
+	output, err := tools.NewTestDataClassRulesTool().Handler(t.Context(), tools.TestDataClassRulesInput{
+		DataClass: tools.DataClassDefinition{Rules: []clients.DataClassRule{{Type: "SOUNDEX", Pattern: "x"}}},
+		Columns:   []tools.ColumnSample{{ColumnName: "name"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Error == "" {
+		t.Errorf("Expected the unsupported rule type to be reported, got: %+v", output)
+	}
+}
@@ -4,22 +4,56 @@ import (
 	"net/http"
 
 	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/metamodel"
 )
 
-func RegisterAll(server *chip.Server, client *http.Client, toolConfig *chip.ToolConfig) {
+func RegisterAll(server *chip.Server, client *http.Client, toolConfig *chip.ToolConfig, metamodelCache *metamodel.Cache) {
 	toolRegister(server, toolConfig, NewAuthHelpTool(client))
-	toolRegister(server, toolConfig, NewAskDadTool(client))
-	toolRegister(server, toolConfig, NewAskGlossaryTool(client))
-	toolRegister(server, toolConfig, NewAssetDetailsTool(client))
-	toolRegister(server, toolConfig, NewSearchKeywordTool(client))
+	if toolConfig.CopilotDisabled {
+		toolRegister(server, toolConfig, NewDiscoverAssetsFallbackTool(client, metamodelCache))
+		toolRegister(server, toolConfig, NewDiscoverGlossaryFallbackTool(client, metamodelCache))
+	} else {
+		copilotHistory := NewCopilotHistory(toolConfig.CopilotHistoryTurns)
+		toolRegister(server, toolConfig, NewAskDadTool(client, copilotHistory))
+		toolRegister(server, toolConfig, NewAskGlossaryTool(client, copilotHistory))
+		toolRegister(server, toolConfig, NewResetCopilotHistoryTool(copilotHistory))
+	}
+	toolRegister(server, toolConfig, NewAssetDetailsTool(client, metamodelCache))
+	toolRegister(server, toolConfig, NewAssetDetailsBatchTool(client, metamodelCache))
+	toolRegister(server, toolConfig, NewAssetHistoryTool(client))
+	toolRegister(server, toolConfig, NewResolveAssetTool(client, metamodelCache))
+	toolRegister(server, toolConfig, NewAssetResponsibilitiesTool(client))
+	toolRegister(server, toolConfig, NewAssignAssetResponsibilityTool(client))
+	toolRegister(server, toolConfig, NewListAssetCommentsTool(client, toolConfig.CommentMarker))
+	toolRegister(server, toolConfig, NewAddAssetCommentTool(client, toolConfig.CommentMarker))
+	toolRegister(server, toolConfig, NewAssetTagsTool(client))
+	toolRegister(server, toolConfig, NewAddAssetTagsTool(client))
+	toolRegister(server, toolConfig, NewRemoveAssetTagsTool(client))
+	toolRegister(server, toolConfig, NewSearchKeywordTool(client, metamodelCache))
+	toolRegister(server, toolConfig, NewGetGlossaryTermTool(client, metamodelCache))
+	toolRegister(server, toolConfig, NewCreateGlossaryTermTool(client, metamodelCache))
+	toolRegister(server, toolConfig, NewUpdateGlossaryTermTool(client, metamodelCache))
 	toolRegister(server, toolConfig, NewSearchDataClassesTool(client))
-	toolRegister(server, toolConfig, NewListAssetTypesTool(client))
+	toolRegister(server, toolConfig, NewCreateDataClassTool(client))
+	toolRegister(server, toolConfig, NewUpdateDataClassTool(client))
+	toolRegister(server, toolConfig, NewTestDataClassRulesTool())
+	toolRegister(server, toolConfig, NewSuggestClassificationsTool(client))
+	toolRegister(server, toolConfig, NewListAssetTypesTool(metamodelCache))
 	toolRegister(server, toolConfig, NewAddDataClassificationMatchTool(client))
 	toolRegister(server, toolConfig, NewSearchClassificationMatchesTool(client))
 	toolRegister(server, toolConfig, NewRemoveDataClassificationMatchTool(client))
+	toolRegister(server, toolConfig, NewBulkAddDataClassificationMatchesTool(client))
+	toolRegister(server, toolConfig, NewBulkRemoveDataClassificationMatchesTool(client))
+	toolRegister(server, toolConfig, NewReviewDataClassificationMatchesTool(client))
 	toolRegister(server, toolConfig, NewListDataContractsTool(client))
 	toolRegister(server, toolConfig, NewPushDataContractManifestTool(client))
 	toolRegister(server, toolConfig, NewPullDataContractManifestTool(client))
+	toolRegister(server, toolConfig, NewValidateDataContractManifestTool())
+	toolRegister(server, toolConfig, NewDiffDataContractManifestTool(client))
+	toolRegister(server, toolConfig, NewListWorkflowDefinitionsTool(client))
+	toolRegister(server, toolConfig, NewStartWorkflowTool(client))
+	toolRegister(server, toolConfig, NewListWorkflowTasksTool(client))
+	toolRegister(server, toolConfig, NewCompleteWorkflowTaskTool(client))
 }
 
 func toolRegister[In, Out any](server *chip.Server, toolConfig *chip.ToolConfig, tool *chip.Tool[In, Out]) {
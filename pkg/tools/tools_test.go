@@ -8,6 +8,9 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"path"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
 )
 
 type testClient struct {
@@ -128,3 +131,25 @@ func StringHandlerInOut(handler func(r *http.Request, in string) (int, string))
 func StringHandlerOut(handler func(r *http.Request) (int, string)) http.Handler {
 	return HttpHandlerOut(MarshallerFunc[string](MarshallString), handler)
 }
+
+func handleMetamodel(handler *http.ServeMux, mm metamodel.Metamodel) {
+	handler.Handle("/rest/2.0/assetTypes", JsonHandlerOut(func(r *http.Request) (int, clients.AssetTypePagedResponse) {
+		return http.StatusOK, clients.AssetTypePagedResponse{Total: int64(len(mm.AssetTypes)), Results: mm.AssetTypes}
+	}))
+	handler.Handle("/rest/2.0/attributeTypes", JsonHandlerOut(func(r *http.Request) (int, clients.AttributeTypePagedResponse) {
+		return http.StatusOK, clients.AttributeTypePagedResponse{Total: int64(len(mm.AttributeTypes)), Results: mm.AttributeTypes}
+	}))
+	handler.Handle("/rest/2.0/relationTypes", JsonHandlerOut(func(r *http.Request) (int, clients.RelationTypePagedResponse) {
+		return http.StatusOK, clients.RelationTypePagedResponse{Total: int64(len(mm.RelationTypes)), Results: mm.RelationTypes}
+	}))
+	handler.Handle("/rest/2.0/statuses", JsonHandlerOut(func(r *http.Request) (int, clients.StatusPagedResponse) {
+		return http.StatusOK, clients.StatusPagedResponse{Total: int64(len(mm.Statuses)), Results: mm.Statuses}
+	}))
+	handler.Handle("/rest/2.0/domainTypes", JsonHandlerOut(func(r *http.Request) (int, clients.DomainTypePagedResponse) {
+		return http.StatusOK, clients.DomainTypePagedResponse{Total: int64(len(mm.DomainTypes)), Results: mm.DomainTypes}
+	}))
+}
+
+func newMetamodelCache(client *http.Client) *metamodel.Cache {
+	return metamodel.NewCache(client, metamodel.Options{})
+}
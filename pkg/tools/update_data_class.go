@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/classification"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/google/uuid"
+)
+
+type UpdateDataClassInput struct {
+	DataClassID         string                  `json:"dataClassId" jsonschema:"Required. The UUID of the data class to update."`
+	Name                *string                 `json:"name,omitempty" jsonschema:"Optional. The new name of the data class."`
+	Description         *string                 `json:"description,omitempty" jsonschema:"Optional. The new description of the data class."`
+	Status              *string                 `json:"status,omitempty" jsonschema:"Optional. The new status of the data class, e.g. DRAFT or PUBLISHED."`
+	ColumnNameFilters   []string                `json:"columnNameFilters,omitempty" jsonschema:"Optional. Regular expressions replacing the column name filters."`
+	ColumnTypeFilters   []string                `json:"columnTypeFilters,omitempty" jsonschema:"Optional. Column data types replacing the column type filters."`
+	AllowNullValues     *bool                   `json:"allowNullValues,omitempty" jsonschema:"Optional. Whether null values are ignored rather than counted as not matching."`
+	AllowEmptyValues    *bool                   `json:"allowEmptyValues,omitempty" jsonschema:"Optional. Whether empty values are ignored rather than counted as not matching."`
+	ConfidenceThreshold *int                    `json:"confidenceThreshold,omitempty" jsonschema:"Optional. The percentage of values a rule must match for the column to be classified, from 1 to 100."`
+	Examples            []string                `json:"examples,omitempty" jsonschema:"Optional. Example values replacing the current ones."`
+	Rules               []clients.DataClassRule `json:"rules,omitempty" jsonschema:"Optional. Rules replacing all current rules of the data class, in the same format as for data_class_create. Keep the id of a rule to update it rather than recreate it."`
+}
+
+func NewUpdateDataClassTool(collibraClient *http.Client) *chip.Tool[UpdateDataClassInput, DataClassWriteOutput] {
+	return &chip.Tool[UpdateDataClassInput, DataClassWriteOutput]{
+		Name:        "data_class_update",
+		Description: "Update a data class in Collibra's classification service. Only the given fields change; given rules replace all rules of the data class. The new threshold, column name filters and rules are checked before they are written.",
+		Handler:     handleUpdateDataClass(collibraClient),
+	}
+}
+
+func handleUpdateDataClass(collibraClient *http.Client) chip.ToolHandlerFunc[UpdateDataClassInput, DataClassWriteOutput] {
+	return func(ctx context.Context, input UpdateDataClassInput) (DataClassWriteOutput, error) {
+		if _, err := uuid.Parse(input.DataClassID); err != nil {
+			return DataClassWriteOutput{Success: false, Error: fmt.Sprintf("Invalid data class ID format: %s", err.Error())}, nil
+		}
+		request := input.changeRequest()
+		if reflect.ValueOf(request).IsZero() {
+			return DataClassWriteOutput{Success: false, Error: "Nothing to update"}, nil
+		}
+		if request.Name != nil && strings.TrimSpace(*request.Name) == "" {
+			return DataClassWriteOutput{Success: false, Error: "Name cannot be empty"}, nil
+		}
+
+		// Only what the update changes is checked: the stored filters and rules may use patterns or rule types
+		// that Collibra accepts but the local engine does not.
+		current := &clients.DataClass{}
+		if input.ColumnNameFilters != nil || input.Rules != nil {
+			var err error
+			if current, err = clients.GetDataClass(ctx, collibraClient, input.DataClassID); err != nil {
+				return DataClassWriteOutput{Success: false, Error: err.Error()}, nil
+			}
+		}
+		if err := input.checkChanges(*current); err != nil {
+			return DataClassWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+
+		dataClass, err := clients.ChangeDataClass(ctx, collibraClient, input.DataClassID, request)
+		if err != nil {
+			return DataClassWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		return DataClassWriteOutput{DataClass: dataClass, Success: true}, nil
+	}
+}
+
+func (in UpdateDataClassInput) changeRequest() clients.ChangeDataClassRequest {
+	return clients.ChangeDataClassRequest{
+		Name:                in.Name,
+		Description:         in.Description,
+		Status:              in.Status,
+		ColumnNameFilters:   in.ColumnNameFilters,
+		ColumnTypeFilters:   in.ColumnTypeFilters,
+		AllowNullValues:     in.AllowNullValues,
+		AllowEmptyValues:    in.AllowEmptyValues,
+		ConfidenceThreshold: in.ConfidenceThreshold,
+		Examples:            in.Examples,
+		Rules:               in.Rules,
+	}
+}
+
+// checkChanges checks the threshold, and the column name filters and rules that are not in the current data class.
+func (in UpdateDataClassInput) checkChanges(current clients.DataClass) error {
+	changed := classification.Class{}
+	if in.ConfidenceThreshold != nil {
+		changed.ConfidenceThreshold = *in.ConfidenceThreshold
+	}
+	for _, filter := range in.ColumnNameFilters {
+		if !slices.Contains(current.ColumnNameFilters, filter) {
+			changed.ColumnNameFilters = append(changed.ColumnNameFilters, filter)
+		}
+	}
+	if _, err := classification.Compile(changed); err != nil {
+		return err
+	}
+
+	currentRules := make([]clients.DataClassRule, 0, len(current.Rules))
+	for _, raw := range current.Rules {
+		var rule clients.DataClassRule
+		if err := json.Unmarshal(raw, &rule); err == nil {
+			currentRules = append(currentRules, rule)
+		}
+	}
+	for i, rule := range in.Rules {
+		unchanged := slices.ContainsFunc(currentRules, func(currentRule clients.DataClassRule) bool {
+			return reflect.DeepEqual(currentRule, rule)
+		})
+		if unchanged {
+			continue
+		}
+		if err := classification.CheckRule(rule); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
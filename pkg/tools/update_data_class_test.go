@@ -0,0 +1,138 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+const dataClassID = "cccccccc-0000-0000-0000-000000000001"
+
+func newDataClassServer(t *testing.T, received *map[string]any) *httptest.Server {
+	handler := http.NewServeMux()
+	handler.Handle("GET /rest/classification/v1/dataClasses/{id}", JsonHandlerOut(func(r *http.Request) (int, clients.DataClass) {
+		return http.StatusOK, clients.DataClass{
+			ID:                  r.PathValue("id"),
+			Name:                "Email Address",
+			ConfidenceThreshold: 80,
+			Rules:               []json.RawMessage{json.RawMessage(`{"id":"r1","type":"REGEX","pattern":".+@.+"}`)},
+		}
+	}))
+	handler.Handle("PATCH /rest/classification/v1/dataClasses/{id}", JsonHandlerInOut(func(r *http.Request, request map[string]any) (int, clients.DataClass) {
+		*received = request
+		return http.StatusOK, clients.DataClass{ID: r.PathValue("id"), Name: "Email Address"}
+	}))
+	handler.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+	return httptest.NewServer(handler)
+}
+
+func TestUpdateDataClass(t *testing.T) {
+	var received map[string]any
+	server := newDataClassServer(t, &received)
+	defer server.Close()
+
+	threshold := 95
+	output, err := tools.NewUpdateDataClassTool(newClient(server)).Handler(t.Context(), tools.UpdateDataClassInput{
+		DataClassID:         dataClassID,
+		ConfidenceThreshold: &threshold,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.DataClass == nil {
+		t.Fatalf("Expected the data class to be updated, got: %+v", output)
+	}
+	if len(received) != 1 || received["confidenceThreshold"] != float64(95) {
+		t.Errorf("Expected only the threshold to be sent, got: %v", received)
+	}
+}
+
+func TestUpdateDataClass_Rejected(t *testing.T) {
+	var received map[string]any
+	server := newDataClassServer(t, &received)
+	defer server.Close()
+
+	tool := tools.NewUpdateDataClassTool(newClient(server))
+	threshold := 120
+	for name, input := range map[string]tools.UpdateDataClassInput{
+		"id":        {DataClassID: "email", ConfidenceThreshold: &threshold},
+		"nothing":   {DataClassID: dataClassID},
+		"threshold": {DataClassID: dataClassID, ConfidenceThreshold: &threshold},
+		"rule":      {DataClassID: dataClassID, Rules: []clients.DataClassRule{{Type: clients.DataClassRuleTypeDictionary}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			output, err := tool.Handler(t.Context(), input)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if output.Success || output.Error == "" {
+				t.Errorf("Expected the update to be rejected, got: %+v", output)
+			}
+		})
+	}
+	if received != nil {
+		t.Errorf("Expected nothing to be written, got: %v", received)
+	}
+}
+
+func TestUpdateDataClass_OnlyChangesAreChecked(t *testing.T) {
+	var received map[string]any
+	handler := http.NewServeMux()
+	handler.Handle("GET /rest/classification/v1/dataClasses/{id}", JsonHandlerOut(func(r *http.Request) (int, clients.DataClass) {
+		// Collibra accepts a lookahead and rule types the local engine cannot evaluate.
+		return http.StatusOK, clients.DataClass{
+			ID:                r.PathValue("id"),
+			Name:              "Email Address",
+			ColumnNameFilters: []string{"(?!id$).*mail.*"},
+			Rules: []json.RawMessage{
+				json.RawMessage(`{"id":"r1","type":"REGEX","pattern":"(?=.*@).+"}`),
+				json.RawMessage(`{"id":"r2","type":"ML_MODEL"}`),
+			},
+		}
+	}))
+	handler.Handle("PATCH /rest/classification/v1/dataClasses/{id}", JsonHandlerInOut(func(r *http.Request, request map[string]any) (int, clients.DataClass) {
+		received = request
+		return http.StatusOK, clients.DataClass{ID: r.PathValue("id"), Name: "Email Address"}
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tool := tools.NewUpdateDataClassTool(newClient(server))
+	name := "E-mail Address"
+	stored := []clients.DataClassRule{{ID: "r1", Type: clients.DataClassRuleTypeRegex, Pattern: "(?=.*@).+"}, {ID: "r2", Type: "ML_MODEL"}}
+	for caseName, input := range map[string]tools.UpdateDataClassInput{
+		"name":    {DataClassID: dataClassID, Name: &name},
+		"filters": {DataClassID: dataClassID, ColumnNameFilters: []string{"(?!id$).*mail.*", "email"}},
+		"rules":   {DataClassID: dataClassID, Rules: append(stored, clients.DataClassRule{Type: clients.DataClassRuleTypeColumnName, Pattern: "e?mail"})},
+	} {
+		t.Run(caseName, func(t *testing.T) {
+			received = nil
+			output, err := tool.Handler(t.Context(), input)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if !output.Success || received == nil {
+				t.Errorf("Expected the update to be written, got: %+v", output)
+			}
+		})
+	}
+
+	received = nil
+	output, err := tool.Handler(t.Context(), tools.UpdateDataClassInput{
+		DataClassID: dataClassID,
+		Rules:       append(stored, clients.DataClassRule{Type: clients.DataClassRuleTypeRegex, Pattern: "(?=.*@).*\\.com"}),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || !strings.HasPrefix(output.Error, "invalid rule 3") || received != nil {
+		t.Errorf("Expected the new rule to be rejected, got: %+v", output)
+	}
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/clients"
+	"github.com/collibra/chip/pkg/metamodel"
+	"github.com/google/uuid"
+)
+
+type UpdateGlossaryTermInput struct {
+	TermID          string   `json:"termId" jsonschema:"Required. The UUID of the business term to update."`
+	Name            string   `json:"name,omitempty" jsonschema:"Optional. The new name of the term."`
+	Definition      string   `json:"definition,omitempty" jsonschema:"Optional. The new definition of the term, replacing the current one."`
+	AddAcronyms     []string `json:"addAcronyms,omitempty" jsonschema:"Optional. Acronyms to add to the term."`
+	AddSynonyms     []string `json:"addSynonyms,omitempty" jsonschema:"Optional. Existing business terms to add as synonyms, given as names or UUIDs."`
+	AddRelatedTerms []string `json:"addRelatedTerms,omitempty" jsonschema:"Optional. Existing business terms to add as related terms, given as names or UUIDs."`
+	DryRun          bool     `json:"dryRun,omitempty" jsonschema:"Optional. Set to true to only run the quality checks without updating the term. Default: false."`
+}
+
+func NewUpdateGlossaryTermTool(collibraClient *http.Client, metamodelCache *metamodel.Cache) *chip.Tool[UpdateGlossaryTermInput, GlossaryTermWriteOutput] {
+	return &chip.Tool[UpdateGlossaryTermInput, GlossaryTermWriteOutput]{
+		Name:        "glossary_term_update",
+		Description: "Update a business term: rename it, replace its definition, or add acronyms, synonyms and related terms. The term as it would be after the update is first checked for a missing definition, a circular definition and, when renamed, terms of the same name in its domain; it is only updated when no check fails. Use dryRun to only run the checks.",
+		Handler:     handleUpdateGlossaryTerm(collibraClient, metamodelCache),
+	}
+}
+
+func handleUpdateGlossaryTerm(collibraClient *http.Client, metamodelCache *metamodel.Cache) chip.ToolHandlerFunc[UpdateGlossaryTermInput, GlossaryTermWriteOutput] {
+	return func(ctx context.Context, input UpdateGlossaryTermInput) (GlossaryTermWriteOutput, error) {
+		if _, err := uuid.Parse(input.TermID); err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: fmt.Sprintf("Invalid term ID format: %s", err.Error())}, nil
+		}
+
+		gm, err := loadGlossaryMetamodel(ctx, metamodelCache)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		current, err := getGlossaryTerm(ctx, collibraClient, gm, input.TermID)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: fmt.Sprintf("Failed to retrieve business term: %s", err.Error())}, nil
+		}
+		synonymIDs, err := resolveGlossaryTermNames(ctx, collibraClient, gm, input.AddSynonyms)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		relatedIDs, err := resolveGlossaryTermNames(ctx, collibraClient, gm, input.AddRelatedTerms)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+
+		draft := glossaryTermDraft{ID: current.ID, DomainID: current.DomainID, Name: current.Name, CurrentName: current.Name, Definition: current.Definition}
+		if name := strings.TrimSpace(input.Name); name != "" {
+			draft.Name = name
+		}
+		if strings.TrimSpace(input.Definition) != "" {
+			draft.Definition = input.Definition
+		}
+		for _, term := range append(append([]GlossaryTermReference{}, current.Synonyms...), current.RelatedTerms...) {
+			draft.ReferencedTermIDs = append(draft.ReferencedTermIDs, term.ID)
+		}
+		draft.ReferencedTermIDs = append(append(draft.ReferencedTermIDs, synonymIDs...), relatedIDs...)
+
+		checks, err := checkGlossaryTerm(ctx, collibraClient, gm, draft)
+		if err != nil {
+			return GlossaryTermWriteOutput{Success: false, Error: err.Error()}, nil
+		}
+		if hasBlockingCheck(checks) {
+			return GlossaryTermWriteOutput{Checks: checks, Success: false, Error: "The term did not pass the quality checks"}, nil
+		}
+		if input.DryRun {
+			return GlossaryTermWriteOutput{Checks: checks, Success: true}, nil
+		}
+
+		if draft.Name != current.Name {
+			if _, err := clients.ChangeAsset(ctx, collibraClient, current.ID, clients.ChangeAssetRequest{Name: draft.Name}); err != nil {
+				return GlossaryTermWriteOutput{Checks: checks, Success: false, Error: fmt.Sprintf("Failed to rename business term: %s", err.Error())}, nil
+			}
+		}
+		definition := ""
+		if draft.Definition != current.Definition {
+			definition = draft.Definition
+		}
+		if err := writeGlossaryTermDetails(ctx, collibraClient, gm, current.ID, definition, current.definitionAttributeID, input.AddAcronyms, synonymIDs, relatedIDs); err != nil {
+			return GlossaryTermWriteOutput{Checks: checks, Success: false, Error: fmt.Sprintf("Partially updated business term %s: %s", current.ID, err.Error())}, nil
+		}
+
+		term, err := getGlossaryTerm(ctx, collibraClient, gm, current.ID)
+		if err != nil {
+			return GlossaryTermWriteOutput{Checks: checks, Success: true, Error: fmt.Sprintf("Updated business term %s, but failed to retrieve it: %s", current.ID, err.Error())}, nil
+		}
+		return GlossaryTermWriteOutput{Term: term, Checks: checks, Success: true}, nil
+	}
+}
@@ -0,0 +1,86 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestUpdateGlossaryTerm(t *testing.T) {
+	glossary := newFakeGlossary()
+	termID := glossary.addTerm("Client", "Sales Glossary", "A party that buys.")
+	glossary.addTerm("Order", "Sales Glossary", "A request to deliver goods.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewUpdateGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.UpdateGlossaryTermInput{
+		TermID:          termID,
+		Name:            "Customer",
+		Definition:      "A party that buys goods or services.",
+		AddAcronyms:     []string{"CUST"},
+		AddRelatedTerms: []string{"Order"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || output.Term == nil {
+		t.Fatalf("Expected the term to be updated, got: %+v", output)
+	}
+	term := output.Term
+	if term.Name != "Customer" || term.Definition != "A party that buys goods or services." {
+		t.Errorf("Unexpected term: %+v", term)
+	}
+	if len(term.Acronyms) != 1 || len(term.RelatedTerms) != 1 {
+		t.Errorf("Expected an acronym and a related term, got: %+v", term)
+	}
+	if len(glossary.attributes) != 3 {
+		t.Errorf("Expected the definition to be replaced rather than added, got %d attributes", len(glossary.attributes))
+	}
+}
+
+func TestUpdateGlossaryTerm_RenameToDuplicate(t *testing.T) {
+	glossary := newFakeGlossary()
+	termID := glossary.addTerm("Client", "Sales Glossary", "A party that buys goods or services.")
+	glossary.addTerm("Customer", "Sales Glossary", "A party with a sales contract.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewUpdateGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.UpdateGlossaryTermInput{
+		TermID: termID,
+		Name:   "Customer",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Success || len(output.Checks) != 1 || output.Checks[0].Check != "duplicate_term" {
+		t.Errorf("Expected a duplicate term, got: %+v", output)
+	}
+	if glossary.assets[termID].Name != "Client" {
+		t.Errorf("Expected the term not to be renamed")
+	}
+}
+
+func TestUpdateGlossaryTerm_NameInAnotherGlossary(t *testing.T) {
+	glossary := newFakeGlossary()
+	termID := glossary.addTerm("Customer", "Sales Glossary", "A party that buys.")
+	glossary.addTerm("Customer", "Support Glossary", "A party with a support contract.")
+	server := glossary.server()
+	defer server.Close()
+
+	client := newClient(server)
+	output, err := tools.NewUpdateGlossaryTermTool(client, newMetamodelCache(client)).Handler(t.Context(), tools.UpdateGlossaryTermInput{
+		TermID:     termID,
+		Definition: "A party that buys goods or services.",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !output.Success || len(output.Checks) != 0 {
+		t.Fatalf("Expected the definition to be updated without checks failing, got: %+v", output)
+	}
+	if output.Term.Definition != "A party that buys goods or services." {
+		t.Errorf("Unexpected term: %+v", output.Term)
+	}
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/collibra/chip/pkg/chip"
+	"github.com/collibra/chip/pkg/datacontract"
+)
+
+type ValidateDataContractManifestInput struct {
+	Manifest string `json:"manifest" jsonschema:"Required. The content of the data contract manifest file, in YAML or JSON."`
+}
+
+type ValidateDataContractManifestOutput struct {
+	Valid      bool                   `json:"valid" jsonschema:"Whether the manifest has no errors. Warnings do not make a manifest invalid."`
+	APIVersion string                 `json:"apiVersion,omitempty" jsonschema:"The apiVersion declared by the manifest"`
+	Standard   string                 `json:"standard,omitempty" jsonschema:"The standard the manifest was validated against: ODCS v2 or ODCS v3. Empty when the manifest does not declare an apiVersion of the embedded ODCS JSON Schemas, in which case only its YAML syntax was checked."`
+	Errors     []datacontract.Finding `json:"errors" jsonschema:"The errors, each with the line, column and path of the offending field"`
+	Warnings   []datacontract.Finding `json:"warnings" jsonschema:"The warnings, such as missing recommended fields"`
+}
+
+func NewValidateDataContractManifestTool() *chip.Tool[ValidateDataContractManifestInput, ValidateDataContractManifestOutput] {
+	return &chip.Tool[ValidateDataContractManifestInput, ValidateDataContractManifestOutput]{
+		Name:        "data_contract_manifest_validate",
+		Description: "Validate a data contract manifest against the published Open Data Contract Standard (ODCS) v2.2.2 or v3.0.2 JSON Schema, locally and without calling Collibra. Reports line-numbered errors, and warnings for missing recommended fields. A manifest that is not valid YAML is an error whatever its apiVersion. data_contract_manifest_push runs the same validation before uploading.",
+		Handler:     handleValidateDataContractManifest(),
+	}
+}
+
+func handleValidateDataContractManifest() chip.ToolHandlerFunc[ValidateDataContractManifestInput, ValidateDataContractManifestOutput] {
+	return func(ctx context.Context, input ValidateDataContractManifestInput) (ValidateDataContractManifestOutput, error) {
+		report := datacontract.Validate([]byte(input.Manifest))
+		return ValidateDataContractManifestOutput{
+			Valid:      report.Valid(),
+			APIVersion: report.APIVersion,
+			Standard:   report.Standard,
+			Errors:     report.Errors(),
+			Warnings:   report.Warnings(),
+		}, nil
+	}
+}
@@ -0,0 +1,41 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/collibra/chip/pkg/datacontract"
+	"github.com/collibra/chip/pkg/tools"
+)
+
+func TestValidateDataContractManifest(t *testing.T) {
+	tool := tools.NewValidateDataContractManifestTool()
+
+	output, err := tool.Handler(t.Context(), tools.ValidateDataContractManifestInput{Manifest: `apiVersion: v2.2.2
+kind: DataContract
+uuid: 53581432-6c55-4ba2-a65f-72344a91553a
+version: 1
+status: current
+datasetName: orders
+quantumName: Orders
+type: views`})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Valid || output.Standard != datacontract.StandardODCSv2 {
+		t.Fatalf("Expected an invalid ODCS v2 manifest, got: %+v", output)
+	}
+	if len(output.Errors) != 1 || output.Errors[0].Line != 4 || output.Errors[0].Path != "version" {
+		t.Errorf("Expected the invalid version on line 4, got: %+v", output.Errors)
+	}
+	if len(output.Warnings) != 3 {
+		t.Errorf("Expected warnings for the missing description, dataset and stakeholders, got: %+v", output.Warnings)
+	}
+
+	output, err = tool.Handler(t.Context(), tools.ValidateDataContractManifestInput{Manifest: "kind: DataContract\napiVersion: v3.0.2\nid: [orders"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if output.Valid || len(output.Errors) != 1 || output.Errors[0].Line == 0 {
+		t.Errorf("Expected a line-numbered syntax error, got: %+v", output)
+	}
+}